@@ -10,6 +10,7 @@ import (
 
 	"github.com/NVIDIA/aistore/3rdparty/glog"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -65,6 +66,7 @@ func (g *fsprungroup) _postAdd(action string, mi *fs.MountpathInfo) {
 	dsort.Managers.AbortAll(fmt.Errorf("%q %s", action, mi))
 
 	fspathsConfigAddDel(mi.Path, true /*add*/)
+	cluster.SetMaxCopying(cmn.GCO.Get())
 	go func() {
 		if cmn.GCO.Get().Resilver.Enabled {
 			g.t.runResilver(res.Args{}, nil /*wg*/)
@@ -177,6 +179,7 @@ func (g *fsprungroup) postDD(rmi *fs.MountpathInfo, action string, xres *xs.Resi
 		return
 	}
 	fspathsConfigAddDel(rmi.Path, false /*add*/)
+	cluster.SetMaxCopying(cmn.GCO.Get())
 	glog.Infof("%s: %s %q %s done", g.t, rmi, action, xres)
 
 	// 3. the case of multiple overlapping detach _or_ disable operations
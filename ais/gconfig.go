@@ -17,12 +17,14 @@ import (
 
 	"github.com/NVIDIA/aistore/3rdparty/glog"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/transport"
 )
 
 type (
@@ -217,6 +219,7 @@ func (co *configOwner) setDaemonConfig(toUpdate *cmn.ConfigToUpdate, transient b
 	cmn.GCO.Put(clone)
 	cmn.GCO.PutOverrideConfig(override)
 	co.Unlock()
+	cluster.SetMaxCopying(clone)
 	return
 }
 
@@ -225,6 +228,7 @@ func setConfigInMem(toUpdate *cmn.ConfigToUpdate, config *cmn.Config, asType str
 		if err := cmn.SetLogLevel(*toUpdate.Log.Level); err != nil {
 			return fmt.Errorf("failed to set log level = %s, err: %v", *toUpdate.Log.Level, err)
 		}
+		transport.SetVerbose(bool(glog.FastV(4, glog.SmoduleTransport)))
 	}
 	err = config.UpdateClusterConfig(toUpdate, asType)
 	return
@@ -309,7 +309,7 @@ func (h *htrun) initNetworks() {
 		port                                     = strconv.Itoa(config.HostNet.Port)
 		proto                                    = config.Net.HTTP.Proto
 		addrList, err                            = getLocalIPv4List()
-		k8sDetected                              = k8s.Detect() == nil
+		k8sDetected                              = k8s.DeploymentKind() == k8s.KindK8s
 		pubAddr, intraControlAddr, intraDataAddr cluster.NetInfo
 	)
 	if err != nil {
@@ -500,7 +500,7 @@ func (h *htrun) run() error {
 func (h *htrun) pubListeningAddr(config *cmn.Config) string {
 	var (
 		testingEnv  = config.TestingEnv()
-		k8sDetected = k8s.Detect() == nil
+		k8sDetected = k8s.DeploymentKind() == k8s.KindK8s
 	)
 	if testingEnv && !k8sDetected {
 		return h.si.PubNet.TCPEndpoint()
@@ -1564,6 +1564,7 @@ func (h *htrun) _recvCfg(newConfig *globalConfig, msg *aisMsg, payload msPayload
 	// update assorted read-mostly knobs
 	cmn.Features = newConfig.Features
 	cmn.Timeout.Set(&newConfig.ClusterConfig)
+	cluster.SetMaxCopying(cmn.GCO.Get())
 	return
 }
 
@@ -0,0 +1,200 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/stats"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Streamed list-objects (see apc.QparamLsoStream): a single page delivered as NDJSON -
+// one JSON-encoded cmn.LsoEntry per line, in the ascending order of names.
+// Targets stream entries as they walk; the proxy merges target streams (see mergeLso)
+// and streams the result to the client. There's no trailer: a page that contains
+// fewer than `PageSize` entries is the last one.
+
+// flush the first entry (for the client to start processing asap) and every so often thereafter
+const lsoStreamFlushCnt = 64
+
+type lsoStream struct {
+	w   http.ResponseWriter
+	enc *jsoniter.Encoder
+	n   int
+}
+
+func newLsoStream(w http.ResponseWriter) *lsoStream {
+	w.Header().Set(cos.HdrContentType, cos.ContentNDJSON)
+	return &lsoStream{w: w, enc: jsoniter.NewEncoder(w)}
+}
+
+func (s *lsoStream) write(e *cmn.LsoEntry) error {
+	if err := s.enc.Encode(e); err != nil { // (newline-terminated)
+		return err
+	}
+	if s.n++; s.n == 1 || s.n%lsoStreamFlushCnt == 0 {
+		s.flush()
+	}
+	return nil
+}
+
+func (s *lsoStream) flush() {
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// An error that occurs after the (200 OK) header has been sent can only be communicated
+// by breaking the response - so that the client gets an unexpected EOF rather than
+// a seemingly complete (truncated) page.
+func (s *lsoStream) abort() {
+	if s.n == 0 {
+		return // nothing sent yet - the caller is still free to respond with error
+	}
+	panic(http.ErrAbortHandler)
+}
+
+// proxy: open all target streams and merge them into the response
+func (p *proxy) lsObjsStream(w http.ResponseWriter, r *http.Request, bck *cluster.Bck, lsmsg *apc.LsoMsg, smap *smapX, beg int64) {
+	const tag = "list-objects"
+	switch {
+	case bck.IsRemote() && !lsmsg.IsFlagSet(apc.LsObjCached):
+		err := fmt.Errorf("%s: streaming is not supported when listing remote %s (list cached objects instead)", tag, bck)
+		p.writeErr(w, r, err, http.StatusNotImplemented)
+		return
+	case lsmsg.IsFlagSet(apc.LsArchDir):
+		err := fmt.Errorf("%s: streaming is not supported when listing archived content (%s)", tag, bck)
+		p.writeErr(w, r, err, http.StatusNotImplemented)
+		return
+	}
+	if lsmsg.PageSize == 0 {
+		lsmsg.PageSize = apc.DefaultPageSizeAIS
+	}
+	var (
+		tsis  = smap.Tmap.ActiveNodes()
+		resps = make([]*http.Response, len(tsis))
+		errs  = make([]error, len(tsis))
+		srcs  = make([]io.Reader, 0, len(tsis))
+		body  = cos.MustMarshal(p.newAmsgActVal(apc.ActList, lsmsg))
+		query = bck.AddToQuery(url.Values{apc.QparamLsoStream: []string{"true"}})
+		wg    = &sync.WaitGroup{}
+	)
+	ctx, cancel := context.WithTimeout(r.Context(), apc.LongTimeout) // (cancel target streams when the client goes away)
+	defer cancel()
+	for i, tsi := range tsis {
+		wg.Add(1)
+		go func(i int, tsi *cluster.Snode) {
+			resps[i], errs[i] = p.lsoStreamFrom(ctx, tsi, bck, query, body)
+			wg.Done()
+		}(i, tsi)
+	}
+	wg.Wait()
+	defer func() {
+		for _, resp := range resps {
+			if resp != nil {
+				resp.Body.Close()
+			}
+		}
+	}()
+	for i, err := range errs {
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		srcs = append(srcs, resps[i].Body)
+	}
+
+	s := newLsoStream(w)
+	if err := mergeLso(srcs, lsmsg.PageSize, s.write); err != nil {
+		glog.Errorf("%s: failed to stream %s %s page: %v", p, tag, bck, err)
+		s.abort()
+		p.writeErr(w, r, err)
+		return
+	}
+	p.statsT.AddMany(
+		cos.NamedVal64{Name: stats.ListCount, Value: 1},
+		cos.NamedVal64{Name: stats.ListLatency, Value: mono.SinceNano(beg)},
+	)
+}
+
+func (p *proxy) lsoStreamFrom(ctx context.Context, tsi *cluster.Snode, bck *cluster.Bck, query url.Values,
+	body []byte) (*http.Response, error) {
+	args := cmn.HreqArgs{
+		Method: http.MethodGet,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  query,
+		Body:   body,
+	}
+	req, err := args.Req()
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set(apc.HdrCallerID, p.SID())
+	req.Header.Set(apc.HdrCallerName, p.si.Name())
+	resp, err := p.client.data.Do(req) //nolint:bodyclose // closed by the caller
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list %s at %s: %w", p, bck, tsi, err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		var b bytes.Buffer
+		b.ReadFrom(resp.Body)
+		resp.Body.Close()
+		return nil, cmn.S2HTTPErr(req, b.String(), resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// k-way merge of the (sorted) target streams, up to `pageSize` entries
+func mergeLso(srcs []io.Reader, pageSize uint, cb func(*cmn.LsoEntry) error) error {
+	var (
+		decs  = make([]*cmn.LsoDecoder, len(srcs))
+		heads = make([]*cmn.LsoEntry, len(srcs))
+		next  = func(i int) (err error) {
+			if heads[i], err = decs[i].Next(); err == io.EOF {
+				err = nil
+			}
+			return
+		}
+	)
+	for i, src := range srcs {
+		decs[i] = cmn.NewLsoDecoder(src)
+		if err := next(i); err != nil {
+			return err
+		}
+	}
+	for cnt := uint(0); pageSize == 0 || cnt < pageSize; cnt++ {
+		min := -1
+		for i, e := range heads {
+			if e != nil && (min < 0 || e.Name < heads[min].Name) {
+				min = i
+			}
+		}
+		if min < 0 {
+			break // all done
+		}
+		if err := cb(heads[min]); err != nil {
+			return err
+		}
+		if err := next(min); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1360,6 +1360,27 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *cluster
 		lsmsg.SetFlag(apc.LsObjCached)
 	}
 
+	// explicitly named objects: bypassing IC and the paging
+	if len(lsmsg.ObjNames) > 0 {
+		if len(lsmsg.ObjNames) > apc.MaxLsoObjNames {
+			err := fmt.Errorf("%s: too many object names (%d, max %d)", tag, len(lsmsg.ObjNames), apc.MaxLsoObjNames)
+			p.writeErr(w, r, err)
+			return
+		}
+		lst, err := p.lsObjsNames(bck, lsmsg, smap)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		p.writeLso(w, r, lst, beg)
+		return
+	}
+	// streamed (NDJSON) page: bypassing IC, xactions, and caches
+	if cos.IsParseBool(r.URL.Query().Get(apc.QparamLsoStream)) {
+		p.lsObjsStream(w, r, bck, lsmsg, smap, beg)
+		return
+	}
+
 	tsi, listRemote, wantOnlyRemote, err := p.lsoFlowControls(bck, lsmsg, smap)
 	if err != nil {
 		p.writeErr(w, r, err)
@@ -1404,7 +1425,11 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *cluster
 		return
 	}
 	debug.Assert(lst != nil)
+	p.writeLso(w, r, lst, beg)
+}
 
+func (p *proxy) writeLso(w http.ResponseWriter, r *http.Request, lst *cmn.LsoResult, beg int64) {
+	const tag = "list-objects"
 	if strings.Contains(r.Header.Get(cos.HdrAccept), cos.ContentMsgPack) {
 		if !p.writeMsgPack(w, r, lst, tag) {
 			return
@@ -1416,7 +1441,6 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *cluster
 	// Free memory allocated for temporary slice immediately as it can take up to a few GB
 	lst.Entries = lst.Entries[:0]
 	lst.Entries = nil
-	lst = nil
 
 	delta := mono.SinceNano(beg)
 	p.statsT.AddMany(
@@ -2004,16 +2028,17 @@ func (p *proxy) redirectURL(r *http.Request, si *cluster.Snode, ts time.Time, ne
 // buffer (see: `queryBuffers`) so we won't request the same objects again.
 func (p *proxy) lsObjsA(bck *cluster.Bck, lsmsg *apc.LsoMsg) (allEntries *cmn.LsoResult, err error) {
 	var (
-		aisMsg    *aisMsg
-		args      *bcastArgs
-		entries   cmn.LsoEntries
-		results   sliceResults
-		smap      = p.owner.smap.get()
-		cacheID   = cacheReqID{bck: bck.Bucket(), prefix: lsmsg.Prefix}
-		token     = lsmsg.ContinuationToken
-		props     = lsmsg.PropsSet()
-		hasEnough bool
-		flags     uint32
+		aisMsg     *aisMsg
+		args       *bcastArgs
+		entries    cmn.LsoEntries
+		results    sliceResults
+		smap       = p.owner.smap.get()
+		cacheID    = cacheReqID{bck: bck.Bucket(), prefix: lsmsg.Prefix}
+		token      = lsmsg.ContinuationToken
+		props      = lsmsg.PropsSet()
+		hasEnough  bool
+		flags      uint32
+		incomplete []string
 	)
 	if lsmsg.PageSize == 0 {
 		lsmsg.PageSize = apc.DefaultPageSizeAIS
@@ -2056,22 +2081,22 @@ func (p *proxy) lsObjsA(bck *cluster.Bck, lsmsg *apc.LsoMsg) (allEntries *cmn.Ls
 	// Combine the results.
 	results = p.bcastGroup(args)
 	freeBcArgs(args)
-	for _, res := range results {
-		if res.err != nil {
-			err = res.toErr()
-			freeBcastRes(results)
-			return nil, err
-		}
-		objList := res.v.(*cmn.LsoResult)
-		flags |= objList.Flags
-		p.qm.b.set(lsmsg.UUID, res.si.ID(), objList.Entries, pageSize)
-	}
+	// (unless explicitly asked to list all, including misplaced duplicates)
+	flags, incomplete, err = p.qm.b.setResults(lsmsg.UUID, results, pageSize, lsmsg.IsFlagSet(apc.LsAllowPartial),
+		!lsmsg.IsFlagSet(apc.LsAll) /*dedup*/)
 	freeBcastRes(results)
+	if err != nil {
+		return nil, err
+	}
+	if len(incomplete) > 0 {
+		glog.Warningf("list-objects %s: incomplete page (failed targets: %v)", bck, incomplete)
+	}
 	entries, hasEnough = p.qm.b.get(lsmsg.UUID, token, pageSize)
 	cos.Assert(hasEnough)
 
 endWithCache:
-	if lsmsg.IsFlagSet(apc.UseListObjsCache) {
+	// (never cache partial results)
+	if lsmsg.IsFlagSet(apc.UseListObjsCache) && len(incomplete) == 0 {
 		p.qm.c.set(cacheID, token, entries, pageSize)
 	}
 end:
@@ -2087,9 +2112,10 @@ end:
 	}
 
 	allEntries = &cmn.LsoResult{
-		UUID:    lsmsg.UUID,
-		Entries: entries,
-		Flags:   flags,
+		UUID:       lsmsg.UUID,
+		Entries:    entries,
+		Incomplete: incomplete,
+		Flags:      flags,
 	}
 	if uint(len(entries)) >= pageSize {
 		allEntries.ContinuationToken = entries[len(entries)-1].Name
@@ -2097,6 +2123,53 @@ end:
 	return allEntries, nil
 }
 
+// list explicitly named objects (see apc.LsoMsg.ObjNames): broadcast to all targets
+// and merge the results, preferring properly located objects over misplaced ones
+func (p *proxy) lsObjsNames(bck *cluster.Bck, lsmsg *apc.LsoMsg, smap *smapX) (*cmn.LsoResult, error) {
+	lsmsg.SetFlag(apc.LsObjCached) // present only
+	aisMsg := p.newAmsgActVal(apc.ActList, &lsmsg)
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  bck.AddToQuery(nil),
+		Body:   cos.MustMarshal(aisMsg),
+	}
+	args.timeout = apc.LongTimeout
+	args.smap = smap
+	args.cresv = cresLso{} // -> cmn.LsoResult
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	found := make(map[string]*cmn.LsoEntry, len(lsmsg.ObjNames))
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			return nil, err
+		}
+		for _, e := range res.v.(*cmn.LsoResult).Entries {
+			if prev, ok := found[e.Name]; !ok || (!prev.IsStatusOK() && e.IsStatusOK()) {
+				found[e.Name] = e
+			}
+		}
+	}
+	freeBcastRes(results)
+
+	lst := &cmn.LsoResult{UUID: lsmsg.UUID, Entries: make(cmn.LsoEntries, 0, len(found))}
+	for _, e := range found {
+		lst.Entries = append(lst.Entries, e)
+	}
+	cmn.SortLso(lst.Entries)
+	for _, objName := range lsmsg.ObjNames {
+		if _, ok := found[objName]; !ok {
+			lst.Missing = append(lst.Missing, objName)
+			found[objName] = nil // (in case the name is repeated)
+		}
+	}
+	return lst, nil
+}
+
 func (p *proxy) lsObjsR(bck *cluster.Bck, lsmsg *apc.LsoMsg, smap *smapX, wantOnlyRemote bool) (allEntries *cmn.LsoResult, err error) {
 	var (
 		config     = cmn.GCO.Get()
@@ -2139,12 +2212,23 @@ func (p *proxy) lsObjsR(bck *cluster.Bck, lsmsg *apc.LsoMsg, smap *smapX, wantOn
 	freeBcArgs(args)
 
 	// Combine the results.
-	resLists := make([]*cmn.LsoResult, 0, len(results))
+	var (
+		resLists     = make([]*cmn.LsoResult, 0, len(results))
+		allowPartial = lsmsg.IsFlagSet(apc.LsAllowPartial)
+		incomplete   []string
+	)
 	for _, res := range results {
 		if res.status == http.StatusNotFound {
 			continue
 		}
 		if res.err != nil {
+			if allowPartial {
+				if err == nil {
+					err = res.toErr()
+				}
+				incomplete = append(incomplete, res.si.ID())
+				continue
+			}
 			err = res.toErr()
 			freeBcastRes(results)
 			return nil, err
@@ -2152,8 +2236,16 @@ func (p *proxy) lsObjsR(bck *cluster.Bck, lsmsg *apc.LsoMsg, smap *smapX, wantOn
 		resLists = append(resLists, res.v.(*cmn.LsoResult))
 	}
 	freeBcastRes(results)
+	if len(incomplete) > 0 {
+		if len(resLists) == 0 {
+			return nil, err
+		}
+		err = nil
+		glog.Warningf("list-objects %s: incomplete page (failed targets: %v)", bck, incomplete)
+	}
 
 	allEntries = cmn.MergeLso(resLists, 0)
+	allEntries.Incomplete = incomplete
 	if glog.FastV(4, glog.SmoduleAIS) {
 		glog.Infof("Objects after merge: %d, token: %q", len(allEntries.Entries), allEntries.ContinuationToken)
 	}
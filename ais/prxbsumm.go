@@ -25,6 +25,10 @@ func (p *proxy) bucketSummary(w http.ResponseWriter, r *http.Request, qbck *cmn.
 		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, amsg.Action, amsg.Value, err)
 		return
 	}
+	if err := msg.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
 	debug.Assert(msg.UUID == "" || cos.IsValidUUID(msg.UUID), msg.UUID)
 	if qbck.IsBucket() {
 		bck := (*cluster.Bck)(qbck)
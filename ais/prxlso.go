@@ -76,6 +76,10 @@ type (
 		// Timestamp of the last access to this buffer. Idle buffers get removed
 		// after `lsobjBufferTTL`.
 		lastAccess atomic.Int64
+		// Keep a single entry per object name (preferring the one at its proper
+		// location) - e.g., when the same object is reported by multiple targets
+		// (see apc.GetPropsPlacement).
+		dedup bool
 	}
 
 	// Contains all lsobj buffers.
@@ -188,6 +192,9 @@ func (b *lsobjBuffer) mergeTargetBuffers() (filled bool) {
 	}
 
 	cmn.SortLso(entries)
+	if b.dedup {
+		entries = dedupEntries(entries)
+	}
 
 	if minObj != "" {
 		idx := sort.Search(len(entries), func(i int) bool {
@@ -202,6 +209,22 @@ func (b *lsobjBuffer) mergeTargetBuffers() (filled bool) {
 	return true
 }
 
+// (sorted) entries with the same name are adjacent, the one with the lowest status (apc.LocOK) first
+func dedupEntries(entries cmn.LsoEntries) cmn.LsoEntries {
+	j := 0
+	for _, e := range entries {
+		if j > 0 && entries[j-1].Name == e.Name {
+			continue
+		}
+		entries[j] = e
+		j++
+	}
+	for i := j; i < len(entries); i++ {
+		entries[i] = nil
+	}
+	return entries[:j]
+}
+
 func (b *lsobjBuffer) get(token string, size uint) (entries cmn.LsoEntries, hasEnough bool) {
 	b.lastAccess.Store(mono.NanoTime())
 
@@ -278,6 +301,44 @@ func (b *lsobjBuffers) set(id, targetID string, entries cmn.LsoEntries, size uin
 	v.(*lsobjBuffer).set(targetID, entries, size)
 }
 
+// setResults feeds broadcast (list-objects) results into the buffer `id`.
+// By default, it is all-or-nothing: the first failed target fails the page.
+// With `allowPartial`, failed targets are skipped and returned as `incomplete`
+// (while the buffer treats them as done for this page) - unless all have failed.
+// With `dedup`, the buffer lists each object name only once (see lsobjBuffer.dedup).
+func (b *lsobjBuffers) setResults(id string, results sliceResults, size uint, allowPartial, dedup bool) (flags uint32,
+	incomplete []string, err error) {
+	for _, res := range results {
+		if res.err == nil {
+			continue
+		}
+		if !allowPartial {
+			return 0, nil, res.toErr()
+		}
+		if err == nil {
+			err = res.toErr()
+		}
+		incomplete = append(incomplete, res.si.ID())
+	}
+	if len(incomplete) == len(results) && err != nil {
+		return 0, nil, err
+	}
+	if dedup {
+		v, _ := b.buffers.LoadOrStore(id, &lsobjBuffer{})
+		v.(*lsobjBuffer).dedup = true
+	}
+	for _, res := range results {
+		if res.err != nil {
+			b.set(id, res.si.ID(), nil, size)
+			continue
+		}
+		lst := res.v.(*cmn.LsoResult)
+		flags |= lst.Flags
+		b.set(id, res.si.ID(), lst.Entries, size)
+	}
+	return flags, incomplete, nil
+}
+
 func (b *lsobjBuffers) housekeep() (num int) {
 	b.buffers.Range(func(key, value any) bool {
 		buffer := value.(*lsobjBuffer)
@@ -5,7 +5,16 @@
 package ais
 
 import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmn"
+	jsoniter "github.com/json-iterator/go"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -451,5 +460,123 @@ var _ = Describe("ListObjectsCache+ListObjectsBuffer", func() {
 			_, hasEnough = buffer.get(id, "f", 1)
 			Expect(hasEnough).To(BeFalse())
 		})
+
+		Describe("setResults", func() {
+			result := func(tid string, err error, xs ...string) *callResult {
+				res := &callResult{si: &cluster.Snode{DaeID: tid}, err: err}
+				if err != nil {
+					res.status = http.StatusInternalServerError
+				} else {
+					res.v = &cmn.LsoResult{Entries: makeEntries(xs...)}
+				}
+				return res
+			}
+			var (
+				errFailed = errors.New("target failed to list")
+				results   sliceResults
+			)
+
+			BeforeEach(func() {
+				results = sliceResults{
+					result("target1", nil, "a", "d", "g"),
+					result("target2", errFailed),
+					result("target3", nil, "b", "c"),
+				}
+			})
+
+			It("should fail the entire page by default", func() {
+				_, incomplete, err := buffer.setResults(id, results, 3, false /*allowPartial*/, false /*dedup*/)
+				Expect(err).To(HaveOccurred())
+				Expect(incomplete).To(BeEmpty())
+			})
+
+			It("should return partial results and the incomplete targets", func() {
+				_, incomplete, err := buffer.setResults(id, results, 3, true /*allowPartial*/, false /*dedup*/)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(incomplete).To(Equal([]string{"target2"}))
+
+				entries, hasEnough := buffer.get(id, "", 3)
+				Expect(hasEnough).To(BeTrue())
+				Expect(extractNames(entries)).To(Equal([]string{"a", "b", "c"}))
+			})
+
+			It("should fail when all targets fail", func() {
+				results = sliceResults{result("target1", errFailed), result("target2", errFailed)}
+				_, incomplete, err := buffer.setResults(id, results, 3, true /*allowPartial*/, false /*dedup*/)
+				Expect(err).To(HaveOccurred())
+				Expect(incomplete).To(BeEmpty())
+			})
+
+			It("should list each object once when deduplicating", func() {
+				misplaced := func(tid string, xs ...string) *callResult {
+					res := result(tid, nil, xs...)
+					for _, e := range res.v.(*cmn.LsoResult).Entries {
+						e.Flags = apc.LocMisplacedNode | apc.EntryMisplaced
+					}
+					return res
+				}
+				results = sliceResults{
+					result("target1", nil, "a", "c", "e"),
+					misplaced("target2", "a", "b", "e"), // "a" and "e" are also at their proper location
+					result("target3", nil, "d"),
+				}
+				_, _, err := buffer.setResults(id, results, 3, false /*allowPartial*/, true /*dedup*/)
+				Expect(err).NotTo(HaveOccurred())
+
+				entries, hasEnough := buffer.get(id, "", 5)
+				Expect(hasEnough).To(BeTrue())
+				Expect(extractNames(entries)).To(Equal([]string{"a", "b", "c", "d", "e"}))
+				for _, e := range entries {
+					Expect(e.IsMisplaced()).To(Equal(e.Name == "b"), e.Name)
+				}
+			})
+
+			It("should keep duplicates when not deduplicating", func() {
+				results = sliceResults{result("target1", nil, "a", "b"), result("target2", nil, "a")}
+				_, _, err := buffer.setResults(id, results, 3, false /*allowPartial*/, false /*dedup*/)
+				Expect(err).NotTo(HaveOccurred())
+
+				entries, hasEnough := buffer.get(id, "", 3)
+				Expect(hasEnough).To(BeTrue())
+				Expect(extractNames(entries)).To(Equal([]string{"a", "a", "b"}))
+			})
+		})
+	})
+
+	Describe("ListObjectsStream", func() {
+		ndjson := func(xs ...string) io.Reader {
+			var b bytes.Buffer
+			enc := jsoniter.NewEncoder(&b)
+			for _, x := range xs {
+				Expect(enc.Encode(&cmn.LsoEntry{Name: x, Size: int64(len(x))})).NotTo(HaveOccurred())
+			}
+			return &b
+		}
+		merge := func(pageSize uint, srcs ...io.Reader) (xs []string, err error) {
+			err = mergeLso(srcs, pageSize, func(e *cmn.LsoEntry) error {
+				Expect(e.Size).To(BeEquivalentTo(len(e.Name)))
+				xs = append(xs, e.Name)
+				return nil
+			})
+			return
+		}
+
+		It("should merge target streams in order", func() {
+			xs, err := merge(0, ndjson("a", "d", "g"), ndjson(), ndjson("b", "c", "h"), ndjson("e", "f"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(xs).To(Equal([]string{"a", "b", "c", "d", "e", "f", "g", "h"}))
+		})
+
+		It("should stop at page size", func() {
+			xs, err := merge(4, ndjson("a", "d", "g"), ndjson("b", "c", "h"), ndjson("e", "f"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(xs).To(Equal([]string{"a", "b", "c", "d"}))
+		})
+
+		It("should fail upon a broken stream", func() {
+			broken := io.MultiReader(ndjson("b"), strings.NewReader(`{"name":"c`))
+			_, err := merge(0, ndjson("a", "d"), broken)
+			Expect(err).To(HaveOccurred())
+		})
 	})
 })
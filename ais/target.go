@@ -888,22 +888,13 @@ func (t *target) headObject(w http.ResponseWriter, r *http.Request, query url.Va
 		op.ObjAttrs = *lom.ObjAttrs()
 		op.Location = lom.Location()
 		op.Mirror.Copies = lom.NumCopies()
-		if lom.HasCopies() {
-			lom.Lock(false)
-			for fs := range lom.GetCopies() {
-				if idx := strings.Index(fs, "/@"); idx >= 0 {
-					fs = fs[:idx]
-				}
-				op.Mirror.Paths = append(op.Mirror.Paths, fs)
-			}
-			lom.Unlock(false)
-		} else {
-			fs := lom.FQN
-			if idx := strings.Index(fs, "/@"); idx >= 0 {
-				fs = fs[:idx]
+		_ = lom.ForEachCopy(func(fqn string, _ *fs.MountpathInfo) error {
+			if idx := strings.Index(fqn, "/@"); idx >= 0 {
+				fqn = fqn[:idx]
 			}
-			op.Mirror.Paths = append(op.Mirror.Paths, fs)
-		}
+			op.Mirror.Paths = append(op.Mirror.Paths, fqn)
+			return nil
+		})
 		if lom.Bck().Props.EC.Enabled {
 			if md, err := ec.ObjectMetadata(lom.Bck(), lom.ObjName); err == nil {
 				hasEC = true
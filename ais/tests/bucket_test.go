@@ -6,9 +6,11 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"net/http"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
@@ -415,6 +417,8 @@ func overwriteLomCache(mdwrite apc.WritePolicy, t *testing.T) {
 		n, s, c := entry.Name, entry.Size, entry.Copies
 		tassert.Fatalf(t, s == nsize, "%s: expecting size = %d, got %d", n, nsize, s)
 		tassert.Fatalf(t, c == 2, "%s: expecting copies = %d, got %d", n, 2, c)
+		tassert.Errorf(t, !entry.IsUnderReplicated() && !entry.IsOverReplicated(),
+			"%s: unexpected replication status (flags %#x)", n, entry.Flags)
 	}
 }
 
@@ -1773,6 +1777,49 @@ func makeNCopies(t *testing.T, baseParams api.BaseParams, bck cmn.Bck, ncopies i
 	api.WaitForXactionIdle(baseParams, args)
 }
 
+func TestEnsureCopies(t *testing.T) {
+	const xactTimeout = time.Minute
+	var (
+		m = ioContext{
+			t:   t,
+			num: 1000,
+			bck: cmn.Bck{Provider: apc.AIS, Name: trand.String(10)},
+		}
+		baseParams = tools.BaseAPIParams()
+	)
+	if testing.Short() {
+		m.num = 100
+	}
+	m.initWithCleanupAndSaveState()
+	tools.CheckSkip(t, tools.SkipTestArgs{MinMountpaths: 3})
+
+	tools.CreateBucketWithCleanup(t, m.proxyURL, m.bck, nil)
+	makeNCopies(t, baseParams, m.bck, 2)
+	m.puts()
+	api.WaitForXactionIdle(baseParams, api.XactReqArgs{Kind: apc.ActPutCopies, Bck: m.bck})
+	m.ensureNumCopies(baseParams, 2, false /*greaterOk*/)
+
+	// raise the number of copies and reconcile right away (i.e., without waiting for the
+	// resulting make-n-copies)
+	tlog.Logln("Set copies = 3 and start ensure-copies")
+	_, err := api.SetBucketProps(baseParams, m.bck, &cmn.BucketPropsToUpdate{
+		Mirror: &cmn.MirrorConfToUpdate{Copies: api.Int64(3)},
+	})
+	tassert.CheckFatal(t, err)
+	xactID, err := api.StartXaction(baseParams, api.XactReqArgs{Kind: apc.ActEnsureCopies, Bck: m.bck})
+	tassert.CheckFatal(t, err)
+	args := api.XactReqArgs{ID: xactID, Kind: apc.ActEnsureCopies, Timeout: xactTimeout}
+	_, err = api.WaitForXactionIC(baseParams, args)
+	tassert.CheckFatal(t, err)
+
+	snaps, err := api.QueryXactionSnaps(baseParams, api.XactReqArgs{ID: xactID})
+	tassert.CheckFatal(t, err)
+	objs, _, _ := snaps.ObjCounts(xactID)
+	tassert.Errorf(t, objs == int64(m.num), "expected %d objects processed, got %d", m.num, objs)
+
+	m.ensureNumCopies(baseParams, 3, false /*greaterOk*/)
+}
+
 func TestRemoteBucketMirror(t *testing.T) {
 	var (
 		m = &ioContext{
@@ -3180,3 +3227,138 @@ func TestBucketListAndSummary(t *testing.T) {
 		})
 	}
 }
+
+// prefix-scoped summary vs. full listing filtered on the client side
+func TestBucketSummaryPrefix(t *testing.T) {
+	const prefix = "datasets/2023/"
+	var (
+		bck = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		m1  = &ioContext{t: t, bck: bck, num: 500, prefix: prefix}
+		m2  = &ioContext{t: t, bck: bck, num: 300, prefix: "datasets/2022/"}
+
+		baseParams = tools.BaseAPIParams()
+	)
+	m1.initWithCleanupAndSaveState()
+	m2.initWithCleanup()
+	m1.expectTargets(1)
+	tools.CreateBucketWithCleanup(t, m1.proxyURL, bck, nil)
+	m1.puts()
+	m2.puts()
+
+	msg := &cmn.BsummCtrlMsg{ObjCached: true, BckPresent: true, Prefix: prefix}
+	summaries, err := api.GetBucketSummary(baseParams, cmn.QueryBcks(bck), msg)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(summaries) == 1, "expected exactly one summary, got %d", len(summaries))
+	summ := summaries[0]
+
+	lsmsg := &apc.LsoMsg{}
+	lsmsg.AddProps(apc.GetPropsSize)
+	lst, err := api.ListObjects(baseParams, bck, lsmsg, 0)
+	tassert.CheckFatal(t, err)
+	var (
+		cnt  uint64
+		size uint64
+	)
+	for _, en := range lst.Entries {
+		if strings.HasPrefix(en.Name, prefix) {
+			cnt++
+			size += uint64(en.Size)
+		}
+	}
+	tassert.Errorf(t, cnt == uint64(m1.num), "listed %d objects with prefix %q, expected %d", cnt, prefix, m1.num)
+	tassert.Errorf(t, summ.ObjCount.Present == cnt, "summary: %d objects with prefix %q, expected %d",
+		summ.ObjCount.Present, prefix, cnt)
+	tassert.Errorf(t, summ.TotalSize.PresentObjs == size, "summary: total size %d, expected %d",
+		summ.TotalSize.PresentObjs, size)
+}
+
+func TestListObjectsByNames(t *testing.T) {
+	var (
+		bck = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		m   = &ioContext{t: t, bck: bck, num: 100, prefix: "names/", fileSize: cos.KiB}
+
+		baseParams = tools.BaseAPIParams()
+	)
+	m.initWithCleanupAndSaveState()
+	tools.CreateBucketWithCleanup(t, m.proxyURL, bck, nil)
+	m.puts()
+
+	var (
+		present = m.objNames[:m.num/2]
+		absent  = []string{"names/absent-1", "absent-2", m.objNames[0] + ".absent"}
+		names   = append(append([]string{}, present...), absent...)
+	)
+	lsmsg := &apc.LsoMsg{ObjNames: names}
+	lsmsg.AddProps(apc.GetPropsSize, apc.GetPropsChecksum)
+	lst, err := api.ListObjects(baseParams, bck, lsmsg, 0)
+	tassert.CheckFatal(t, err)
+
+	tassert.Fatalf(t, len(lst.Entries) == len(present), "expected %d entries, got %d", len(present), len(lst.Entries))
+	expected := cos.NewStrSet(present...)
+	for _, en := range lst.Entries {
+		tassert.Errorf(t, expected.Contains(en.Name), "unexpected entry %q", en.Name)
+		tassert.Errorf(t, en.Size == int64(m.fileSize), "%s: size %d, expected %d", en.Name, en.Size, m.fileSize)
+		tassert.Errorf(t, en.Checksum != "", "%s: missing checksum", en.Name)
+		tassert.Errorf(t, en.IsStatusOK(), "%s: unexpected status %d", en.Name, en.Status())
+	}
+	tassert.Errorf(t, reflect.DeepEqual(lst.Missing, absent), "expected missing %v, got %v", absent, lst.Missing)
+
+	// all absent
+	lst, err = api.ListObjects(baseParams, bck, &apc.LsoMsg{ObjNames: absent}, 0)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst.Entries) == 0 && len(lst.Missing) == len(absent),
+		"expected no entries and %d missing, got (%d, %d)", len(absent), len(lst.Entries), len(lst.Missing))
+}
+
+func TestListObjectsStream(t *testing.T) {
+	const pageSize = 64
+	var (
+		bck = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		m   = &ioContext{t: t, bck: bck, num: 300, prefix: "stream/", fileSize: cos.KiB}
+
+		baseParams = tools.BaseAPIParams()
+	)
+	m.initWithCleanupAndSaveState()
+	tools.CreateBucketWithCleanup(t, m.proxyURL, bck, nil)
+	m.puts()
+
+	// paged (default) vs streamed
+	lsmsg := &apc.LsoMsg{Prefix: m.prefix, PageSize: pageSize}
+	lsmsg.AddProps(apc.GetPropsSize)
+	lst, err := api.ListObjects(baseParams, bck, lsmsg, 0)
+	tassert.CheckFatal(t, err)
+
+	var (
+		streamed []*cmn.LsoEntry
+		pages    int
+	)
+	lsmsg = &apc.LsoMsg{Prefix: m.prefix, PageSize: pageSize}
+	lsmsg.AddProps(apc.GetPropsSize)
+	for {
+		n, err := api.ListObjectsStream(baseParams, bck, lsmsg, func(en *cmn.LsoEntry) error {
+			if l := len(streamed); l > 0 {
+				tassert.Fatalf(t, streamed[l-1].Name < en.Name, "out of order: %q after %q", en.Name, streamed[l-1].Name)
+			}
+			streamed = append(streamed, en)
+			return nil
+		})
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, n <= pageSize, "page %d: %d entries exceed page size %d", pages, n, pageSize)
+		pages++
+		if lsmsg.ContinuationToken == "" {
+			break
+		}
+	}
+	tlog.Logf("streamed %d entries in %d pages\n", len(streamed), pages)
+	tassert.Fatalf(t, len(streamed) == m.num, "expected %d streamed entries, got %d", m.num, len(streamed))
+	tassert.Fatalf(t, len(lst.Entries) == len(streamed), "paged %d vs streamed %d", len(lst.Entries), len(streamed))
+	for i, en := range streamed {
+		tassert.Errorf(t, en.Name == lst.Entries[i].Name, "[%d]: streamed %q vs paged %q", i, en.Name, lst.Entries[i].Name)
+		tassert.Errorf(t, en.Size == int64(m.fileSize), "%s: size %d, expected %d", en.Name, en.Size, m.fileSize)
+	}
+
+	// callback error terminates the listing
+	errStop := errors.New("stop")
+	n, err := api.ListObjectsStream(baseParams, bck, &apc.LsoMsg{Prefix: m.prefix}, func(*cmn.LsoEntry) error { return errStop })
+	tassert.Errorf(t, err == errStop && n == 1, "expected callback error after 1 entry, got (%d, %v)", n, err)
+}
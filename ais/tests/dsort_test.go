@@ -78,6 +78,8 @@ type (
 		outputShardCnt        int
 		recordDuplicationsCnt int
 		recordExts            []string
+		nameOrder             archive.NameOrder // intra-shard ordering of record names (tar only)
+		seed                  int64             // to reproduce record names (see archive.CreateTarWithOrderedFiles)
 
 		extension       string
 		algorithm       *dsort.SortAlgorithm
@@ -272,6 +274,10 @@ func (df *dsortFramework) createInputShards() {
 			}
 			if df.algorithm.Kind == dsort.SortKindContent {
 				err = archive.CreateTarWithCustomFiles(tarName, df.fileInTarballCnt, df.fileInTarballSize, df.algorithm.FormatType, df.algorithm.Extension, df.missingKeys)
+			} else if df.extension == cos.ExtTar && (df.nameOrder != archive.NameOrderRandom || df.seed != 0) {
+				prefix := fmt.Sprintf("%d-", i)
+				err = archive.CreateTarWithOrderedFiles(tarName, df.fileInTarballCnt, df.fileInTarballSize, df.recordExts,
+					prefix, df.nameOrder, df.seed+int64(i))
 			} else if df.extension == cos.ExtTar {
 				err = archive.CreateTarWithRandomFiles(tarName, df.fileInTarballCnt, df.fileInTarballSize, duplication, df.recordExts, nil)
 			} else if df.extension == cos.ExtTarTgz {
@@ -647,6 +653,53 @@ func TestDistributedSort(t *testing.T) {
 	)
 }
 
+// input shards with records that are already sorted, reverse-sorted, or shuffled by name
+func TestDistributedSortNameOrder(t *testing.T) {
+	for _, order := range []archive.NameOrder{archive.NameOrderAsc, archive.NameOrderDesc, archive.NameOrderShuffle} {
+		order := order // pin
+		t.Run(strconv.Itoa(int(order)), func(t *testing.T) {
+			runDSortTest(
+				t, dsortTestSpec{p: true, types: dsorterTypes},
+				func(dsorterType string, t *testing.T) {
+					var (
+						m = &ioContext{
+							t: t,
+						}
+						df = &dsortFramework{
+							m:                m,
+							dsorterType:      dsorterType,
+							tarballCnt:       100,
+							fileInTarballCnt: 50,
+							recordExts:       []string{".txt", ".cls"},
+							nameOrder:        order,
+							seed:             int64(order) * 1000,
+							maxMemUsage:      "99%",
+						}
+					)
+
+					m.initWithCleanupAndSaveState()
+					m.expectTargets(3)
+
+					tools.CreateBucketWithCleanup(t, m.proxyURL, m.bck, nil)
+
+					df.init()
+					df.createInputShards()
+
+					tlog.Logln("starting distributed sort...")
+					df.start()
+
+					_, err := tools.WaitForDSortToFinish(m.proxyURL, df.managerUUID)
+					tassert.CheckFatal(t, err)
+					tlog.Logln("finished distributed sort")
+
+					df.checkMetrics(false /* expectAbort */)
+					df.checkOutputShards(5)
+				},
+			)
+		})
+	}
+}
+
 func TestDistributedSortWithNonExistingBuckets(t *testing.T) {
 	runDSortTest(
 		t, dsortTestSpec{p: true, types: dsorterTypes},
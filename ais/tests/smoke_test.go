@@ -8,14 +8,24 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/tools/tlog"
 )
 
 func TestSmoke(t *testing.T) {
 	objSizes := [3]uint64{3 * cos.KiB, 19 * cos.KiB, 77 * cos.KiB}
 
 	runProviderTests(t, func(t *testing.T, bck *cluster.Bck) {
+		var (
+			copies   = int(bck.Props.Mirror.Copies)
+			mirrored = bck.Props.Mirror.Enabled && copies >= 2
+		)
 		for _, objSize := range objSizes {
 			name := fmt.Sprintf("size:%s", cos.B2S(int64(objSize), 0))
 			t.Run(name, func(t *testing.T) {
@@ -34,9 +44,49 @@ func TestSmoke(t *testing.T) {
 				m.initWithCleanup()
 
 				m.puts()
+				if mirrored {
+					checkSmokeCopies(t, &m, copies)
+				}
 				m.gets()
 				m.del()
+				if mirrored {
+					checkSmokeNoCopies(t, &m)
+				}
 			})
 		}
 	})
 }
+
+// mirrored bucket: each object must have exactly the configured number of copies
+// on distinct mountpaths
+func checkSmokeCopies(t *testing.T, m *ioContext, copies int) {
+	baseParams := tools.BaseAPIParams()
+	// copies are made asynchronously
+	api.WaitForXactionIdle(baseParams, api.XactReqArgs{Kind: apc.ActPutCopies, Bck: m.bck, Timeout: rebalanceTimeout})
+
+	tlog.Logf("checking %d copies of %d objects\n", copies, len(m.objNames))
+	for _, objName := range m.objNames {
+		props, err := api.HeadObject(baseParams, m.bck, objName, apc.FltPresent)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, props.Mirror.Copies == copies, "%s: expected %d copies, got %d",
+			objName, copies, props.Mirror.Copies)
+		mpaths := make(cos.StrSet, len(props.Mirror.Paths))
+		mpaths.Add(props.Mirror.Paths...)
+		tassert.Errorf(t, len(mpaths) == copies && len(props.Mirror.Paths) == copies,
+			"%s: expected %d copies on distinct mountpaths, got %v", objName, copies, props.Mirror.Paths)
+	}
+}
+
+// mirrored bucket: deleting an object removes all its copies
+func checkSmokeNoCopies(t *testing.T, m *ioContext) {
+	baseParams := tools.BaseAPIParams()
+	for _, objName := range m.objNames {
+		_, err := api.HeadObject(baseParams, m.bck, objName, apc.FltPresent)
+		tassert.Errorf(t, err != nil && cmn.IsStatusNotFound(err), "%s: expected not found, got %v", objName, err)
+	}
+	msg := &apc.LsoMsg{Flags: apc.LsObjCached, Prefix: m.prefix}
+	msg.AddProps(apc.GetPropsCopies)
+	lst, err := api.ListObjects(baseParams, m.bck, msg, 0)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst.Entries) == 0, "expected no objects (and no copies) after delete, got %d", len(lst.Entries))
+}
@@ -205,6 +205,19 @@ func (t *target) listObjects(w http.ResponseWriter, r *http.Request, bck *cluste
 		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, actMsg.Action, actMsg.Value, err)
 		return
 	}
+	// explicitly named objects: no walking and no xaction
+	if len(msg.ObjNames) > 0 {
+		lst, err := xs.LsoNames(t, bck, msg)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		return t.writeMsgPack(w, r, lst, "list_objects")
+	}
+	// streamed (NDJSON) page: no xaction
+	if cos.IsParseBool(r.URL.Query().Get(apc.QparamLsoStream)) {
+		return t.lsoStream(w, r, bck, msg)
+	}
 	if !bck.IsAIS() && !msg.IsFlagSet(apc.LsObjCached) {
 		maxRemotePageSize := t.Backend(bck).MaxPageSize()
 		if msg.PageSize > maxRemotePageSize {
@@ -246,8 +259,12 @@ func (t *target) listObjects(w http.ResponseWriter, r *http.Request, bck *cluste
 		runtime.Gosched()
 	}
 	xls := xctn.(*xs.LsoXact)
-	resp := xls.Do(msg) // NOTE: blocking request/response
+	resp := xls.Do(r.Context(), msg) // NOTE: blocking request/response
 	if resp.Err != nil {
+		if err := r.Context().Err(); err != nil {
+			glog.Warningf("%s: %s list-objects[%s] canceled by the client: %v", t, bck, msg.UUID, err)
+			return false
+		}
 		t.writeErr(w, r, resp.Err, resp.Status)
 		return false
 	}
@@ -261,6 +278,18 @@ func (t *target) listObjects(w http.ResponseWriter, r *http.Request, bck *cluste
 	return t.writeMsgPack(w, r, resp.Lst, "list_objects")
 }
 
+func (t *target) lsoStream(w http.ResponseWriter, r *http.Request, bck *cluster.Bck, msg *apc.LsoMsg) bool {
+	s := newLsoStream(w)
+	err := xs.LsoStream(r.Context(), t, bck, msg, s.write)
+	if err == nil {
+		return true
+	}
+	glog.Errorf("%s: failed to stream %s list-objects page: %v", t, bck, err)
+	s.abort()
+	t.writeErr(w, r, err)
+	return false
+}
+
 func (t *target) bsumm(w http.ResponseWriter, r *http.Request, q url.Values, action string, bck *cluster.Bck, msg *cmn.BsummCtrlMsg) {
 	var (
 		taskAction = q.Get(apc.QparamTaskAction)
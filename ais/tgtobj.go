@@ -654,7 +654,19 @@ validate:
 // 2) other targets (when resilvering or rebalancing is running (aka GFN))
 // 3) other targets if the bucket erasure coded
 // 4) Cloud
+// (in-flight restores and their outcomes are tracked - see stats.RestoreInflight)
 func (goi *getObjInfo) restoreFromAny(skipLomRestore bool) (doubleCheck bool, errCode int, err error) {
+	goi.t.statsT.Add(stats.RestoreInflight, 1)
+	doubleCheck, errCode, err = goi._restoreAny(skipLomRestore)
+	outcome := cos.NamedVal64{Name: stats.RestoreCount, Value: 1}
+	if err != nil {
+		outcome.Name = stats.ErrRestoreCount
+	}
+	goi.t.statsT.AddMany(cos.NamedVal64{Name: stats.RestoreInflight, Value: -1}, outcome)
+	return
+}
+
+func (goi *getObjInfo) _restoreAny(skipLomRestore bool) (doubleCheck bool, errCode int, err error) {
 	var (
 		tsi   *cluster.Snode
 		smap  = goi.t.owner.smap.get()
@@ -802,7 +814,7 @@ func (goi *getObjInfo) finalize(coldGet bool) (retry bool, errCode int, err erro
 		fqn    = goi.lom.FQN
 	)
 	if !coldGet && !goi.isGFN {
-		fqn = goi.lom.LBGet() // best-effort GET load balancing (see also mirror.findLeastUtilized())
+		fqn = goi.lom.LBGetFor(goi.lom.SizeBytes()) // best-effort GET load balancing (see also mirror.findLeastUtilized())
 	}
 	lmfh, err = os.Open(fqn)
 	if err != nil {
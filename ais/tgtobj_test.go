@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"sync"
 	"testing"
 	"time"
 
@@ -18,8 +19,13 @@ import (
 	"github.com/NVIDIA/aistore/cluster/mock"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/res"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/tools/readers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
 )
 
 const (
@@ -38,6 +44,13 @@ type (
 	discardRW struct {
 		w io.Writer
 	}
+	// records added values and the peak of each (see restoreFromAny)
+	recStatsTracker struct {
+		mock.StatsTracker
+		vals map[string]int64
+		peak map[string]int64
+		mu   sync.Mutex
+	}
 )
 
 func newDiscardRW() *discardRW {
@@ -50,6 +63,29 @@ func (drw *discardRW) Write(p []byte) (int, error) { return drw.w.Write(p) }
 func (*discardRW) Header() http.Header             { return make(http.Header) }
 func (*discardRW) WriteHeader(int)                 {}
 
+func newRecStatsTracker() *recStatsTracker {
+	return &recStatsTracker{vals: make(map[string]int64), peak: make(map[string]int64)}
+}
+
+func (r *recStatsTracker) Add(name string, val int64) {
+	r.mu.Lock()
+	r.vals[name] += val
+	r.peak[name] = cos.MaxI64(r.peak[name], r.vals[name])
+	r.mu.Unlock()
+}
+
+func (r *recStatsTracker) AddMany(nvs ...cos.NamedVal64) {
+	for _, nv := range nvs {
+		r.Add(nv.Name, nv.Value)
+	}
+}
+
+func (r *recStatsTracker) Get(name string) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.vals[name]
+}
+
 func TestMain(m *testing.M) {
 	flag.Parse()
 
@@ -266,3 +302,64 @@ func BenchmarkObjGetDiscard(b *testing.B) {
 		})
 	}
 }
+
+var _ = Describe("restoreFromAny", func() {
+	var (
+		tracker *recStatsTracker
+		saved   stats.Tracker
+		lom     *cluster.LOM
+	)
+	BeforeEach(func() {
+		tracker, saved = newRecStatsTracker(), t.statsT
+		t.statsT = tracker
+		if t.res == nil {
+			t.res = res.New(t)
+		}
+		smap := newSmap()
+		smap.Tmap[t.si.ID()] = t.si
+		smap.Version = 1
+		t.owner.smap.put(smap)
+
+		lom = cluster.AllocLOM("restore-obj")
+		Expect(lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal})).NotTo(HaveOccurred())
+	})
+	AfterEach(func() {
+		t.statsT = saved
+		os.Remove(lom.FQN)
+		cluster.FreeLOM(lom)
+	})
+
+	restore := func() error {
+		goi := &getObjInfo{atime: time.Now().UnixNano(), t: t, lom: lom, w: io.Discard}
+		_, _, err := goi.restoreFromAny(false /*skipLomRestore*/)
+		return err
+	}
+
+	It("should track in-flight restores and count failures", func() {
+		Expect(restore()).To(HaveOccurred())
+		Expect(tracker.peak[stats.RestoreInflight]).To(BeEquivalentTo(1))
+		Expect(tracker.Get(stats.RestoreInflight)).To(BeZero())
+		Expect(tracker.Get(stats.RestoreCount)).To(BeZero())
+		Expect(tracker.Get(stats.ErrRestoreCount)).To(BeEquivalentTo(1))
+	})
+
+	It("should track in-flight restores and count successes", func() {
+		r, _ := readers.NewRandReader(cos.KiB, cos.ChecksumNone)
+		poi := &putObjInfo{atime: time.Now(), t: t, lom: lom, r: r, workFQN: path.Join(testMountpath, "restore-obj.work")}
+		_, err := poi.putObject()
+		Expect(err).NotTo(HaveOccurred())
+
+		// interrupted resilvering: restore from local copies
+		_, err = fs.PersistMarker(fname.ResilverMarker)
+		Expect(err).NotTo(HaveOccurred())
+		defer fs.RemoveMarker(fname.ResilverMarker)
+
+		for i := 0; i < 3; i++ {
+			Expect(restore()).NotTo(HaveOccurred())
+		}
+		Expect(tracker.peak[stats.RestoreInflight]).To(BeEquivalentTo(1))
+		Expect(tracker.Get(stats.RestoreInflight)).To(BeZero())
+		Expect(tracker.Get(stats.RestoreCount)).To(BeEquivalentTo(3))
+		Expect(tracker.Get(stats.ErrRestoreCount)).To(BeZero())
+	})
+})
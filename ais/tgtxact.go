@@ -207,6 +207,26 @@ func (t *target) cmdXactStart(xactMsg *xact.QueryMsg, bck *cluster.Bck) error {
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(t, xactMsg.ID, bck)
 		return rns.Err
+	case apc.ActEnsureCopies, apc.ActRebalanceCopies:
+		var rns xreg.RenewRes
+		if xactMsg.Kind == apc.ActEnsureCopies {
+			rns = xreg.RenewBckEnsureCopies(t, xactMsg.ID, bck)
+		} else {
+			rns = xreg.RenewBckRebalanceCopies(t, xactMsg.ID, bck)
+		}
+		if rns.Err != nil {
+			return rns.Err
+		}
+		xctn := rns.Entry.Get()
+		xctn.AddNotif(&xact.NotifXact{
+			NotifBase: nl.NotifBase{
+				When: cluster.UponTerm,
+				Dsts: []string{equalIC},
+				F:    t.callerNotifyFin,
+			},
+			Xact: xctn,
+		})
+		go xctn.Run(nil)
 	// 3. cannot start
 	case apc.ActPutCopies:
 		return fmt.Errorf("cannot start %q (is driven by PUTs into a mirrored bucket)", xactMsg)
@@ -64,7 +64,7 @@ func getLocalIPv4List() (addrlist []*localIPv4Info, err error) {
 
 	var (
 		testingEnv  = cmn.GCO.Get().TestingEnv()
-		k8sDetected = k8s.Detect() == nil
+		k8sDetected = k8s.DeploymentKind() == k8s.KindK8s
 	)
 	for _, addr := range addrs {
 		curr := &localIPv4Info{}
@@ -215,7 +215,7 @@ func validateHostname(hostname string) (err error) {
 /////////////
 
 func deploymentType() string {
-	if k8s.Detect() == nil {
+	if k8s.DeploymentKind() == k8s.KindK8s {
 		return apc.DeploymentK8s
 	} else if cmn.GCO.Get().TestingEnv() {
 		return apc.DeploymentDev
@@ -5,6 +5,7 @@
 package ais_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -67,3 +68,66 @@ func TestStrToBytes(t *testing.T) {
 		}
 	}
 }
+
+// B2S => S2B round trip (exact when the value is a whole number of units)
+func TestStrToBytesRoundTrip(t *testing.T) {
+	tests := []int64{
+		0, 1, 1023, cos.KiB, cos.KiB + 1, 1000 * cos.KiB, cos.MiB - 1, cos.MiB, 3 * cos.GiB,
+		17 * cos.TiB, 2048 * cos.TiB,
+	}
+	for _, val := range tests {
+		for _, digits := range []int{0, 2, 6} {
+			s := cos.B2S(val, digits)
+			n, err := cos.S2B(s)
+			if err != nil {
+				t.Fatalf("%d => %q: %v", val, s, err)
+			}
+			// B2S rounds to the specified number of digits (and computes in float32)
+			diff := n - val
+			if diff < 0 {
+				diff = -diff
+			}
+			if val < cos.KiB && diff != 0 {
+				t.Errorf("%d => %q => %d", val, s, n)
+			}
+			if digits == 6 && float64(diff) > float64(val)*1e-6 {
+				t.Errorf("%d => %q => %d (diff %d)", val, s, n, diff)
+			}
+		}
+	}
+}
+
+func TestStrToBytesSuffixes(t *testing.T) {
+	tests := []struct {
+		str string
+		val int64
+	}{
+		{"77KiB", 77 * cos.KiB},
+		{"77kib", 77 * cos.KiB},
+		{"77KB", 77 * cos.KiB}, // NOTE: KB == KiB (see S2B)
+		{"77k", 77 * cos.KiB},
+		{" 2.5GB ", 5 * cos.GiB / 2},
+		{"2.5 GiB", 5 * cos.GiB / 2},
+		{"0.25t", cos.TiB / 4},
+		{"1.5", 1},
+		{"0", 0},
+	}
+	for _, tst := range tests {
+		n, err := cos.S2B(tst.str)
+		if err != nil {
+			t.Errorf("Failed to convert %q: %v", tst.str, err)
+		} else if n != tst.val {
+			t.Errorf("%q: expected %d got %d", tst.str, tst.val, n)
+		}
+	}
+}
+
+func TestStrToBytesErrors(t *testing.T) {
+	for _, s := range []string{"KiB", "B", "abc", "1XB", "1KiBB", "1.2.3MB", "NaN", "inf", "9999999TiB", "1 K i B", "-1", "-5KB"} {
+		if n, err := cos.S2B(s); err == nil {
+			t.Errorf("%q: expected error, got %d", s, n)
+		} else if !strings.Contains(err.Error(), s) {
+			t.Errorf("%q: expected descriptive error, got %v", s, err)
+		}
+	}
+}
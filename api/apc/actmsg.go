@@ -15,39 +15,41 @@ import (
 // ActionMsg.Action
 // includes Xaction.Kind == ActionMsg.Action (when the action is asynchronous)
 const (
-	ActCreateBck      = "create-bck"  // NOTE: compare w/ ActAddRemoteBck below
-	ActDestroyBck     = "destroy-bck" // destroy bucket data and metadata
-	ActSummaryBck     = "summary-bck"
-	ActCopyBck        = "copy-bck"
-	ActDownload       = "download"
-	ActECEncode       = "ec-encode" // erasure code a bucket
-	ActECGet          = "ec-get"    // erasure decode objects
-	ActECPut          = "ec-put"    // erasure encode objects
-	ActECRespond      = "ec-resp"   // respond to other targets' EC requests
-	ActETLInline      = "etl-inline"
-	ActETLBck         = "etl-bck"
-	ActElection       = "election"
-	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
-	ActInvalListCache = "inval-listobj-cache"
-	ActLRU            = "lru"
-	ActList           = "list"
-	ActLoadLomCache   = "load-lom-cache"
-	ActMakeNCopies    = "make-n-copies"
-	ActMoveBck        = "move-bck"
-	ActNewPrimary     = "new-primary"
-	ActPromote        = "promote"
-	ActPutCopies      = "put-copies"
-	ActRebalance      = "rebalance"
-	ActRenameObject   = "rename-obj"
-	ActResetBprops    = "reset-bprops"
-	ActResetConfig    = "reset-config"
-	ActResilver       = "resilver"
-	ActResyncBprops   = "resync-bprops"
-	ActSetBprops      = "set-bprops"
-	ActSetConfig      = "set-config"
-	ActShutdown       = "shutdown"
-	ActStartGFN       = "start-gfn"
-	ActStoreCleanup   = "cleanup-store"
+	ActCreateBck       = "create-bck"  // NOTE: compare w/ ActAddRemoteBck below
+	ActDestroyBck      = "destroy-bck" // destroy bucket data and metadata
+	ActSummaryBck      = "summary-bck"
+	ActCopyBck         = "copy-bck"
+	ActDownload        = "download"
+	ActECEncode        = "ec-encode" // erasure code a bucket
+	ActECGet           = "ec-get"    // erasure decode objects
+	ActECPut           = "ec-put"    // erasure encode objects
+	ActECRespond       = "ec-resp"   // respond to other targets' EC requests
+	ActETLInline       = "etl-inline"
+	ActETLBck          = "etl-bck"
+	ActElection        = "election"
+	ActEnsureCopies    = "ensure-copies"
+	ActEvictRemoteBck  = "evict-remote-bck" // evict remote bucket's data
+	ActInvalListCache  = "inval-listobj-cache"
+	ActLRU             = "lru"
+	ActList            = "list"
+	ActLoadLomCache    = "load-lom-cache"
+	ActMakeNCopies     = "make-n-copies"
+	ActMoveBck         = "move-bck"
+	ActNewPrimary      = "new-primary"
+	ActPromote         = "promote"
+	ActPutCopies       = "put-copies"
+	ActRebalanceCopies = "rebalance-copies"
+	ActRebalance       = "rebalance"
+	ActRenameObject    = "rename-obj"
+	ActResetBprops     = "reset-bprops"
+	ActResetConfig     = "reset-config"
+	ActResilver        = "resilver"
+	ActResyncBprops    = "resync-bprops"
+	ActSetBprops       = "set-bprops"
+	ActSetConfig       = "set-config"
+	ActShutdown        = "shutdown"
+	ActStartGFN        = "start-gfn"
+	ActStoreCleanup    = "cleanup-store"
 
 	// multi-object (via `SelectObjsMsg`)
 	ActCopyObjects     = "copy-listrange"
@@ -77,8 +77,11 @@ const (
 	HdrXactionID = HeaderPrefix + "xaction-id"
 
 	// Stream related headers.
-	HdrSessID   = HeaderPrefix + "session-id"
-	HdrCompress = HeaderPrefix + "compress" // LZ4Compression, etc.
+	HdrSessID    = HeaderPrefix + "session-id"
+	HdrSessNet   = HeaderPrefix + "session-net" // network the stream is established over (cmn.KnownNetworks)
+	HdrCompress  = HeaderPrefix + "compress"    // LZ4Compression, etc.
+	HdrRxMaxRate = HeaderPrefix + "rx-max-rate" // desired max send rate (bytes/s) advertised by the receiver
+	HdrRxLimited = HeaderPrefix + "rx-limited"  // receiver may reject new streams - senders must pre-flight
 
 	// Promote(dir)
 	HdrPromoteNamesHash = HeaderPrefix + "promote-names-hash"
@@ -73,12 +73,18 @@ const (
 	// simply forwards it to the associated remote backend and delivers the results as is to the
 	// requesting proxy and, subsequently, to client.
 	LsWantOnlyRemoteProps
+
+	// When some targets fail to list, return what the rest did list (instead of failing
+	// the entire request) and report the failed ones in `LsoResult.Incomplete`
+	LsAllowPartial
 )
 
 // List objects default page size
 const (
 	DefaultPageSizeAIS   = 10000
 	DefaultPageSizeCloud = 1000
+
+	MaxLsoObjNames = DefaultPageSizeAIS // max number of names in a single `LsoMsg.ObjNames` request
 )
 
 const (
@@ -92,6 +98,13 @@ const (
 	// Flags
 	EntryIsCached = 1 << (EntryStatusBits + 1)
 	EntryInArch   = 1 << (EntryStatusBits + 2)
+
+	// (see GetPropsReplication below)
+	EntryUnderReplicated = 1 << (EntryStatusBits + 3)
+	EntryOverReplicated  = 1 << (EntryStatusBits + 4)
+
+	// (see GetPropsPlacement below)
+	EntryMisplaced = 1 << (EntryStatusBits + 5)
 )
 
 // ObjEntry.Flags field
@@ -113,6 +126,14 @@ const (
 	GetPropsEC       = "ec"
 	GetPropsCustom   = "custom"
 	GetPropsLocation = "location" // advanced usage
+
+	// advanced usage: number of copies _and_ EntryUnderReplicated/EntryOverReplicated flags
+	// (as per bucket's mirror.copies); more expensive than "copies" and never included by default
+	GetPropsReplication = "replication"
+
+	// advanced usage (cached objects only): location _and_ EntryMisplaced flag for objects
+	// that are not at their HRW location (target and mountpath); never included by default
+	GetPropsPlacement = "placement"
 )
 
 // NOTE: update when changing any of the above :NOTE
@@ -121,7 +142,8 @@ var (
 	GetPropsDefaultAIS   = []string{GetPropsName, GetPropsSize, GetPropsChecksum, GetPropsAtime}
 	GetPropsDefaultCloud = []string{GetPropsName, GetPropsSize, GetPropsChecksum, GetPropsVersion, GetPropsCustom}
 	GetPropsAll          = append(GetPropsDefaultAIS,
-		GetPropsVersion, GetPropsCached, GetPropsStatus, GetPropsCopies, GetPropsEC, GetPropsCustom, GetPropsLocation)
+		GetPropsVersion, GetPropsCached, GetPropsStatus, GetPropsCopies, GetPropsEC, GetPropsCustom, GetPropsLocation,
+		GetPropsReplication, GetPropsPlacement)
 )
 
 type LsoMsg struct {
@@ -134,6 +156,9 @@ type LsoMsg struct {
 	SID               string `json:"target"`             // selected target to solely execute backend.list-objects
 	Flags             uint64 `json:"flags,string"`       // enum {LsObjCached, ...} - see above
 	PageSize          uint   `json:"pagesize"`           // max entries returned by list objects call
+	// list only the explicitly named objects that are present in the cluster
+	// (single page, up to MaxLsoObjNames; absent names are returned in `LsoResult.Missing`)
+	ObjNames []string `json:"objnames,omitempty"`
 }
 
 ////////////
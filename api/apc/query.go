@@ -67,6 +67,10 @@ const (
 	// - we simply don't care.
 	QparamSkipVC = "skip_vc"
 
+	// list-objects: stream the (single) page as it gets produced - one JSON-encoded
+	// LsoEntry per line (NDJSON) - instead of responding with the entire page
+	QparamLsoStream = "lso_stream"
+
 	// force the operation; allows to overcome certain restrictions (e.g., shutdown primary and the entire cluster)
 	// or errors (e.g., attach invalid mountpath)
 	QparamForce = "frc"
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"sort"
@@ -488,6 +489,59 @@ func ListObjectsPage(bp BaseParams, bck cmn.Bck, lsmsg *apc.LsoMsg) (*cmn.LsoRes
 	return page, nil
 }
 
+// ListObjectsStream lists a single page (of up to `lsmsg.PageSize` entries) and, unlike
+// ListObjectsPage, calls back with each entry as soon as it arrives (see apc.QparamLsoStream),
+// in the ascending order of names. Supported for ais buckets and cached objects of remote buckets.
+// Upon return, `lsmsg.ContinuationToken` is set to the name of the last listed entry
+// unless the page was the last one (i.e., contained fewer than page-size entries).
+func ListObjectsStream(bp BaseParams, bck cmn.Bck, lsmsg *apc.LsoMsg, cb func(*cmn.LsoEntry) error) (n uint, err error) {
+	var (
+		body     io.ReadCloser
+		last     string
+		pageSize = lsmsg.PageSize
+	)
+	if pageSize == 0 {
+		pageSize = apc.DefaultPageSizeAIS
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(url.Values{apc.QparamLsoStream: []string{"true"}})
+		reqParams.Body = cos.MustMarshal(apc.ActionMsg{Action: apc.ActList, Value: lsmsg})
+	}
+	body, err = reqParams.doReader()
+	FreeRp(reqParams)
+	if err != nil {
+		return
+	}
+	defer body.Close()
+	dec := cmn.NewLsoDecoder(body)
+	for {
+		var entry *cmn.LsoEntry
+		if entry, err = dec.Next(); err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			break
+		}
+		n++
+		last = entry.Name
+		if err = cb(entry); err != nil {
+			return
+		}
+	}
+	if err == nil {
+		lsmsg.ContinuationToken = ""
+		if n >= pageSize {
+			lsmsg.ContinuationToken = last
+		}
+	}
+	return
+}
+
 // TODO: obsolete this function after introducing mechanism to detect remote bucket changes.
 func ListObjectsInvalidateCache(bp BaseParams, bck cmn.Bck) error {
 	var (
@@ -0,0 +1,111 @@
+// Package cluster provides common interfaces and local access to cluster-level metadata
+/*
+ * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cluster
+
+import (
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/3rdparty/atomic"
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+//
+// lazy checksumming of local copies (see `mirror.lazy_cksum`):
+// the copy is made without computing its checksum inline, and gets verified later,
+// in the background, against the checksum of the object at the time of copying.
+// A copy that fails verification is removed - to be recreated (see EnsureCopies).
+// NOTE: "checksum pending" is an in-memory state that does not survive restarts
+//
+
+const maxCksumPending = 1024 // beyond that, copies are checksummed inline
+
+type lazyCksum struct {
+	cksum   *cos.Cksum // expected
+	bck     cmn.Bck
+	objName string
+	fqn     string // copy to verify
+}
+
+var (
+	cksumCh      chan *lazyCksum
+	cksumOnce    sync.Once
+	cksumPending atomic.Int64 // queued + being verified
+	cksumBad     atomic.Int64 // failed verification (cumulative)
+)
+
+// LazyCksumStats returns the number of copies pending checksum verification,
+// and the (cumulative) number of copies that failed it
+func LazyCksumStats() (pending, bad int64) { return cksumPending.Load(), cksumBad.Load() }
+
+// reserve a place in the queue, or return false if the backlog is full
+func lazyCksumAdmit() bool {
+	if cksumPending.Inc() > maxCksumPending {
+		cksumPending.Dec()
+		return false
+	}
+	return true
+}
+
+// enqueue upon a successful lazyCksumAdmit (that must be undone via cksumPending.Dec otherwise)
+func (lom *LOM) lazyCksum(copyFQN string, cksum *cos.Cksum) {
+	cksumOnce.Do(func() {
+		cksumCh = make(chan *lazyCksum, maxCksumPending)
+		go cksumWorker()
+	})
+	cksumCh <- &lazyCksum{cksum: cksum.Clone(), bck: *lom.Bucket(), objName: lom.ObjName, fqn: copyFQN}
+}
+
+func cksumWorker() {
+	buf, slab := T.PageMM().Alloc()
+	defer slab.Free(buf)
+	for item := range cksumCh {
+		item.verify(buf)
+		cksumPending.Dec()
+	}
+}
+
+func (item *lazyCksum) verify(buf []byte) {
+	cksum, err := cos.ChecksumFile(item.fqn, item.cksum.Ty(), buf)
+	if err != nil {
+		if !os.IsNotExist(err) { // removed in the meantime - nothing to do
+			glog.Errorf("failed to checksum copy %q: %v", item.fqn, err)
+		}
+		return
+	}
+	if cksum.Equal(item.cksum) {
+		return
+	}
+
+	// flag for repair: remove the copy unless the object has changed in the meantime
+	lom := AllocLOM(item.objName)
+	defer FreeLOM(lom)
+	if err := lom.InitBck(&item.bck); err != nil {
+		return
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	lom.Uncache(false /*delDirty*/)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return
+	}
+	if !lom.Checksum().Equal(item.cksum) {
+		return // overwritten (the copy is not current anyway)
+	}
+	if _, ok := lom.md.copies[item.fqn]; !ok || item.fqn == lom.FQN {
+		return
+	}
+	cksumBad.Inc()
+	glog.Errorf("%s: copy %q: %v", lom, item.fqn, cos.NewBadDataCksumError(&cksum.Cksum, item.cksum))
+	if err := lom.DelCopies(item.fqn); err != nil {
+		glog.Error(err)
+		return
+	}
+	if err := lom.Persist(); err != nil {
+		glog.Error(err)
+	}
+}
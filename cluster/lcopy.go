@@ -5,19 +5,147 @@
 package cluster
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"math"
 	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/NVIDIA/aistore/3rdparty/atomic"
 	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/xoshiro256"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/OneOfOne/xxhash"
 )
 
 //
 // LOM copy management
 //
 
+const (
+	maxHealing      = 64  // max number of concurrent copy-on-read (heal-on-GET) goroutines
+	delBatchSize    = 256 // (see DelAllCopiesBatch)
+	dfltCopyingMult = 4   // default max concurrent copies per mountpath (see config.Disk.MaxCopying)
+
+	lbLargeRead = 4 * cos.MiB // reads of this size and larger are load-balanced by leastLoadedCopy
+
+	maxMirrorCopies = 32 // max per-object override of mirror.copies (see SetMirrorCopies)
+
+	// restoring from a given copy: retries upon transient (I/O) errors (see restoreFrom)
+	maxRestoreRetries = 3
+	restoreRetryWait  = 100 * time.Millisecond
+
+	// source mtime that is more recent than this is ambiguous (see sameSizeMtime)
+	racyMtime = 2 * time.Second
+)
+
+// enum: handling an existing destination (see Copy2FQNMode)
+type Collision int
+
+const (
+	CollOverwrite = Collision(iota) // overwrite (default)
+	CollSkip                        // keep the destination and skip (cmn.ErrSkip)
+	CollFail                        // keep the destination and fail (cmn.ErrObjExists)
+)
+
+var numHealing atomic.Int32
+
+// file operations on objects and their copies - an interface for the tests
+// to be able to inject failures (see lcopy_hook_test.go)
+type (
+	fileOps interface {
+		copyFile(src, dst string, buf []byte, cksumType string) (int64, *cos.CksumHash, error)
+		rename(src, dst string) error
+		remove(fqn string) error
+	}
+	osFileOps struct{}
+)
+
+var fops fileOps = osFileOps{}
+
+func (osFileOps) copyFile(src, dst string, buf []byte, cksumType string) (int64, *cos.CksumHash, error) {
+	return cos.CopyFile(src, dst, buf, cksumType)
+}
+func (osFileOps) rename(src, dst string) error { return cos.Rename(src, dst) }
+func (osFileOps) remove(fqn string) error      { return cos.RemoveFile(fqn) }
+
+// optional observer of copy placement decisions (see RegPlacementCB)
+type PlacementCB func(lom *LOM, chosen *fs.MountpathInfo, candidates fs.MPI)
+
+var placementCB PlacementCB
+
+// target-wide throttling of local copies (see copyFile)
+var (
+	copySema   = cos.NewDynSemaphore(dfltCopyingMult)
+	numCopying atomic.Int64
+	numStarted atomic.Int64
+	copyWaitNs atomic.Int64
+)
+
+// CopyStats returns the number of local copies currently in progress, and the number of copies
+// started so far along with the total (cumulative) time they've spent waiting for the copying semaphore
+func CopyStats() (inflight, started int64, wait time.Duration) {
+	return numCopying.Load(), numStarted.Load(), time.Duration(copyWaitNs.Load())
+}
+
+// SetMaxCopying (re)sizes the copying semaphore (see copyFile) - upon startup and
+// whenever the config or the set of available mountpaths changes (see config.Disk.MaxCopying)
+func SetMaxCopying(config *cmn.Config) {
+	size := int(config.Disk.MaxCopying)
+	if size <= 0 {
+		size = cos.Max(len(fs.GetAvail()), 1) * dfltCopyingMult
+	}
+	if copySema.Size() != size {
+		copySema.SetSize(size)
+	}
+}
+
+// all local copies go through here to bound the total number of concurrent copies
+// (regardless of the caller); the limit is configurable and can change at runtime
+func copyFile(srcFQN, dstFQN string, buf []byte, cksumType string) (dstCksum *cos.CksumHash, err error) {
+	started := mono.NanoTime()
+	copySema.Acquire()
+	copyWaitNs.Add(mono.SinceNano(started))
+	numStarted.Inc()
+	numCopying.Inc()
+	_, dstCksum, err = fops.copyFile(srcFQN, dstFQN, buf, cksumType)
+	numCopying.Dec()
+	copySema.Release()
+	return
+}
+
+// fail fast: check the destination's free space (as last reported by fs) prior to
+// creating the workfile - unless the capacity is yet unknown or the check is disabled
+// (see feat.SkipCopySpaceCheck); the returned error satisfies cos.IsErrOOS
+func checkSpace(mi *fs.MountpathInfo, size int64) error {
+	if size <= 0 || cmn.Features.IsSet(feat.SkipCopySpaceCheck) {
+		return nil
+	}
+	if c := mi.GetCapacity(); !fitsCapacity(c, size) {
+		return fmt.Errorf("%s: not enough space to copy %s (avail %s): %w",
+			mi, cos.B2S(size, 2), cos.B2S(int64(c.Avail), 2), syscall.ENOSPC)
+	}
+	return nil
+}
+
+func fitsCapacity(c fs.Capacity, size int64) bool {
+	if c.Used == 0 && c.Avail == 0 {
+		return true // not refreshed yet
+	}
+	return c.Avail >= uint64(size)
+}
+
 func (lom *LOM) whingeCopy() (yes bool) {
 	if !lom.IsCopy() {
 		return
@@ -28,11 +156,196 @@ func (lom *LOM) whingeCopy() (yes bool) {
 	return true
 }
 
+// VerifyMeta checks copies-related metadata invariants (that are otherwise only
+// asserted in debug builds - see whingeCopy) and returns a descriptive error upon
+// the first violation, namely:
+// - the object must be at its HRW location, i.e., not be a copy;
+// - when there are copies, the object itself must be one of them;
+// - each copy must reside on its recorded mountpath and parse as this same object;
+// - no two copies may share a mountpath.
+// NOTE: caller must take a lock
+func (lom *LOM) VerifyMeta() error {
+	if lom.IsCopy() {
+		return fmt.Errorf("%s: [fqn=%s] is a copy, expecting hrw location %s", lom, lom.FQN, lom.HrwFQN)
+	}
+	if len(lom.md.copies) == 0 {
+		return nil
+	}
+	if _, ok := lom.md.copies[lom.FQN]; !ok {
+		return fmt.Errorf("%s: has %d copies that do not include the object itself [fqn=%s]",
+			lom, len(lom.md.copies), lom.FQN)
+	}
+	mpaths := make(cos.StrKVs, len(lom.md.copies))
+	for fqn, mi := range lom.md.copies {
+		if mi == nil {
+			return fmt.Errorf("%s: copy %q: no mountpath recorded", lom, fqn)
+		}
+		if other, ok := mpaths[mi.Path]; ok {
+			return fmt.Errorf("%s: duplicate mountpath %s (copies %q and %q)", lom, mi, other, fqn)
+		}
+		mpaths[mi.Path] = fqn
+	}
+	for fqn, mi := range lom.md.copies {
+		parsed, err := fs.ParseFQN(fqn)
+		if err != nil {
+			return fmt.Errorf("%s: invalid copy %q: %w", lom, fqn, err)
+		}
+		if parsed.MpathInfo.Path != mi.Path {
+			return fmt.Errorf("%s: copy %q: mountpath mismatch (%s vs recorded %s)", lom, fqn, parsed.MpathInfo, mi)
+		}
+		if parsed.ContentType != fs.ObjectType || parsed.ObjName != lom.ObjName || !parsed.Bck.Equal(lom.Bucket()) {
+			return fmt.Errorf("%s: copy %q resolves to a different object", lom, fqn)
+		}
+	}
+	return nil
+}
+
 func (lom *LOM) HasCopies() bool { return lom.NumCopies() > 1 }
 func (lom *LOM) NumCopies() int  { return cos.Max(len(lom.md.copies), 1) } // TODO: compare with `gotCopies` and unify
 
+// CountCopies returns the number of copies (including self) as per metadata (same as NumCopies)
+// or, if `verify` is true, the number of those that also exist on disk on available mountpaths
+// (at the cost of a stat per copy)
+// NOTE: caller must take a lock (or else work with its own loaded LOM)
+func (lom *LOM) CountCopies(verify bool) (n int) {
+	if !verify {
+		return lom.NumCopies()
+	}
+	if len(lom.md.copies) == 0 {
+		if cos.Stat(lom.FQN) == nil {
+			n = 1
+		}
+		return
+	}
+	avail := fs.GetAvail()
+	for fqn, mi := range lom.md.copies {
+		if _, ok := avail[mi.Path]; ok && cos.Stat(fqn) == nil {
+			n++
+		}
+	}
+	return
+}
+
+// PhysicalSize returns the total number of bytes stored across all copies (including self):
+// the logical size times the number of copies as per metadata, or, if `verify` is true,
+// the sum of the sizes of those copies that actually exist on disk on available mountpaths
+// (see CountCopies); it is the same as the logical size for an object without copies
+// NOTE: caller must take a lock (or else work with its own loaded LOM)
+func (lom *LOM) PhysicalSize(verify bool) (size int64) {
+	if !verify {
+		return lom.SizeBytes() * int64(lom.NumCopies())
+	}
+	if len(lom.md.copies) == 0 {
+		if finfo, err := os.Stat(lom.FQN); err == nil {
+			size = finfo.Size()
+		}
+		return
+	}
+	avail := fs.GetAvail()
+	for fqn, mi := range lom.md.copies {
+		if _, ok := avail[mi.Path]; !ok {
+			continue
+		}
+		if finfo, err := os.Stat(fqn); err == nil {
+			size += finfo.Size()
+		}
+	}
+	return
+}
+
+// ExpectedCopies returns the number of copies (including self) this object is supposed
+// to have: one, unless mirroring is enabled (see MirrorCopies)
+func (lom *LOM) ExpectedCopies() int {
+	if !lom.MirrorConf().Enabled {
+		return 1
+	}
+	return int(cos.MaxI64(lom.MirrorCopies(), 1))
+}
+
+// MirrorCopies returns the desired number of copies of this object: per-object
+// override, if set, or otherwise bucket's `mirror.copies` - or `mirror.cold_copies`
+// if the object hasn't been accessed for `mirror.age_limit` (and longer)
+func (lom *LOM) MirrorCopies() int64 {
+	if lom.md.ncopies > 0 {
+		return int64(lom.md.ncopies)
+	}
+	mirror := lom.MirrorConf()
+	if mirror.AgeLimit > 0 && lom.isCold(mirror.AgeLimit.D()) {
+		return mirror.ColdCopies
+	}
+	return mirror.Copies
+}
+
+// age is the time since last access, as per the object's (loaded) metadata - no fstat
+// NOTE: prefetch sets atime=-now (see isValidAtime)
+func (lom *LOM) isCold(ageLimit time.Duration) bool {
+	atime := lom.md.Atime
+	if atime < 0 {
+		atime = -atime
+	}
+	return isValidAtime(atime) && time.Since(time.Unix(0, atime)) >= ageLimit
+}
+
+// SetMirrorCopies overrides bucket's `mirror.copies` for this specific object;
+// zero resets the override. Takes effect only when mirroring is enabled.
+// NOTE: caller must take wlock and persist
+func (lom *LOM) SetMirrorCopies(n int) error {
+	if n < 0 || n > maxMirrorCopies {
+		return fmt.Errorf("%s: invalid num copies %d, must be in [0, %d] range", lom, n, maxMirrorCopies)
+	}
+	lom.md.ncopies = int16(n)
+	return nil
+}
+
+// PinCopy marks the copy as immovable: a pinned copy is never relocated (see EvacuateCopiesFrom),
+// trimmed as an extra one, or dropped from metadata when its mountpath goes away (see ToMpath) -
+// until unpinned or deleted explicitly (see UnpinCopy, DelCopies). The object's default (HRW)
+// location is not a copy and cannot be pinned.
+// NOTE: caller must take wlock and persist
+func (lom *LOM) PinCopy(copyFQN string) error {
+	if _, ok := lom.md.copies[copyFQN]; !ok {
+		return fmt.Errorf("%s: cannot pin %q - not a copy", lom, copyFQN)
+	}
+	if copyFQN == lom.HrwFQN {
+		return fmt.Errorf("%s: cannot pin the default location %q", lom, copyFQN)
+	}
+	if lom.md.pinned == nil {
+		lom.md.pinned = make(cos.StrSet, 1)
+	}
+	lom.md.pinned.Add(copyFQN)
+	return lom.syncMetaWithCopies()
+}
+
+// NOTE: caller must take wlock and persist
+func (lom *LOM) UnpinCopy(copyFQN string) error {
+	if !lom.IsPinned(copyFQN) {
+		return nil
+	}
+	lom.md.pinned.Delete(copyFQN)
+	if len(lom.md.pinned) == 0 {
+		lom.md.pinned = nil
+	}
+	return lom.syncMetaWithCopies()
+}
+
+func (lom *LOM) IsPinned(copyFQN string) bool { return lom.md.pinned.Contains(copyFQN) }
+
+// IsOverReplicated returns true if the object has more copies than configured
+// (`mirror.copies`), along with the number of those extra copies; returns false
+// when mirroring is disabled.
+// To remediate, remove the excess via DelCopies (and persist) - note that,
+// unlike the latter, DelExtraCopies only cleans up replicas that are _not_ in
+// the object's metadata (and therefore does not change NumCopies).
+func (lom *LOM) IsOverReplicated() (bool, int) {
+	if !lom.MirrorConf().Enabled {
+		return false, 0
+	}
+	excess := lom.NumCopies() - lom.ExpectedCopies()
+	return excess > 0, cos.Max(excess, 0)
+}
+
 // GetCopies returns all copies (NOTE that copies include self)
-// NOTE: caller must take a lock
+// NOTE: caller must take a lock and must not modify the returned map (see ForEachCopy)
 func (lom *LOM) GetCopies() fs.MPI {
 	debug.AssertFunc(func() bool {
 		rc, exclusive := lom.IsLocked()
@@ -41,6 +354,34 @@ func (lom *LOM) GetCopies() fs.MPI {
 	return lom.md.copies
 }
 
+// ForEachCopy calls `fn` for each copy (including self, and including the case
+// of no copies - see NumCopies) in the order of their FQNs, and stops upon the
+// first error returned by the callback.
+// Unlike GetCopies, takes rlock and iterates a snapshot - the callback is free
+// to take the lock (or do anything else) on its own.
+// NOTE: caller must not hold the lock
+func (lom *LOM) ForEachCopy(fn func(fqn string, mi *fs.MountpathInfo) error) error {
+	lom.Lock(false)
+	copies := make([]string, 0, len(lom.md.copies))
+	mpis := make(fs.MPI, len(lom.md.copies))
+	for fqn, mi := range lom.md.copies {
+		copies = append(copies, fqn)
+		mpis[fqn] = mi
+	}
+	lom.Unlock(false)
+
+	if len(copies) == 0 {
+		return fn(lom.FQN, lom.mpathInfo)
+	}
+	sort.Strings(copies)
+	for _, fqn := range copies {
+		if err := fn(fqn, mpis[fqn]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // given an existing (on-disk) object, determines whether it is a _copy_
 // (compare with isMirror below)
 func (lom *LOM) IsCopy() bool {
@@ -60,10 +401,100 @@ func (lom *LOM) isMirror(dst *LOM) bool {
 		lom.Bck().Equal(dst.Bck(), true /* must have same BID*/, true /* same backend */)
 }
 
+// given metadata-equal existing copy, determines whether its content can be trusted to be
+// identical: yes when checksums prove it; otherwise, compares freshly computed checksums
+// (see feat.CompareCopyContent)
+func (lom *LOM) sameContent(cplom *LOM, buf []byte) bool {
+	if cksum := lom.Checksum(); !cksum.IsEmpty() && cksum.Equal(cplom.Checksum()) {
+		return true
+	}
+	if !cmn.Features.IsSet(feat.CompareCopyContent) {
+		return true
+	}
+	src, err := cos.ChecksumFile(lom.FQN, cos.ChecksumXXHash, buf)
+	if err != nil {
+		glog.Errorf("%s: %v", lom, err)
+		return false
+	}
+	dst, err := cos.ChecksumFile(cplom.FQN, cos.ChecksumXXHash, buf)
+	if err != nil {
+		glog.Errorf("%s: %v", cplom, err)
+		return false
+	}
+	return src.Equal(&dst.Cksum)
+}
+
+// Fast path to tell that an existing copy is identical - with no need to compare content:
+// same size and same mtime (the latter carried over from the source when copying - see
+// carryMtime); the copy's own metadata must still agree (see sameCksumVer).
+// Ambiguous mtime never qualifies: whole-second (coarse-grained) timestamps, and recent
+// ones - a rewrite within the same timestamp tick would go unnoticed.
+func (lom *LOM) sameSizeMtime(finfo os.FileInfo) bool {
+	if cmn.Features.IsSet(feat.CompareCopyContent) {
+		return false
+	}
+	srcfi, err := os.Stat(lom.FQN)
+	if err != nil || srcfi.Size() != lom.SizeBytes() || srcfi.Size() != finfo.Size() {
+		return false
+	}
+	return !isRacyMtime(srcfi.ModTime()) && srcfi.ModTime().Equal(finfo.ModTime())
+}
+
+// same version, and same checksum unless neither has one
+func (lom *LOM) sameCksumVer(cplom *LOM) bool {
+	if lom.Version(true) != cplom.Version(true) {
+		return false
+	}
+	cksum := lom.Checksum()
+	if cksum.IsEmpty() && cplom.Checksum().IsEmpty() {
+		return true
+	}
+	return cksum.Equal(cplom.Checksum())
+}
+
+// set the copy's mtime to the source's, unless ambiguous
+func (lom *LOM) carryMtime(copyFQN string) {
+	srcfi, err := os.Stat(lom.FQN)
+	if err != nil || isRacyMtime(srcfi.ModTime()) {
+		return
+	}
+	if err := os.Chtimes(copyFQN, time.Now(), srcfi.ModTime()); err != nil {
+		glog.Errorf("%s: %v", lom, err) // (not critical: the copy won't qualify for the fast path)
+	}
+}
+
+func isRacyMtime(mtime time.Time) bool {
+	return mtime.Nanosecond() == 0 || time.Since(mtime) < racyMtime
+}
+
+// verifyCopies drops (in memory) copy FQNs that do not parse back into this object's
+// bucket and name - e.g., stale xattr after bucket rename or a misplaced file
+// (see feat.VerifyCopiesOnLoad)
+func (lom *LOM) verifyCopies() {
+	for copyFQN := range lom.md.copies {
+		if copyFQN == lom.FQN {
+			continue
+		}
+		parsed, err := fs.ParseFQN(copyFQN)
+		if err == nil && parsed.ContentType == fs.ObjectType &&
+			parsed.ObjName == lom.ObjName && parsed.Bck.Equal(lom.Bucket()) {
+			continue
+		}
+		if err != nil {
+			glog.Errorf("%s: dropping invalid copy %q: %v", lom, copyFQN, err)
+		} else {
+			glog.Errorf("%s: dropping copy %q that belongs to %s", lom, copyFQN,
+				filepath.Join(parsed.Bck.String(), parsed.ObjName))
+		}
+		lom.delCopyMd(copyFQN)
+	}
+}
+
 func (lom *LOM) delCopyMd(copyFQN string) {
 	delete(lom.md.copies, copyFQN)
+	lom.md.pinned.Delete(copyFQN)
 	if len(lom.md.copies) <= 1 {
-		lom.md.copies = nil
+		lom.md.copies, lom.md.pinned = nil, nil
 	}
 }
 
@@ -78,27 +509,48 @@ func (lom *LOM) AddCopy(copyFQN string, mpi *fs.MountpathInfo) error {
 }
 
 func (lom *LOM) DelCopies(copiesFQN ...string) (err error) {
-	numCopies := lom.NumCopies()
+	_, err = lom.delCopies(copiesFQN, false /*keep*/)
+	return
+}
+
+// DetachCopies removes the copies from the object's metadata (and from the metadata
+// of its remaining copies) while keeping the replica files in place - to be moved,
+// archived, or adopted by the caller. Returns the detached FQNs.
+// NOTE: a detached file still carries (stale) metadata that lists it as a copy;
+// it is up to the caller to relocate or otherwise dispose of it.
+func (lom *LOM) DetachCopies(copiesFQN ...string) (detached []string, err error) {
+	return lom.delCopies(copiesFQN, true /*keep*/)
+}
+
+func (lom *LOM) delCopies(copiesFQN []string, keep bool) (detached []string, err error) {
+	var (
+		numCopies = lom.NumCopies()
+		mpaths    = make([]string, len(copiesFQN))
+	)
 	// 1. Delete all copies from the metadata
-	for _, copyFQN := range copiesFQN {
-		if _, ok := lom.md.copies[copyFQN]; !ok {
-			return fmt.Errorf("lom %s(num: %d): copy %s does not exist", lom, numCopies, copyFQN)
+	for i, copyFQN := range copiesFQN {
+		mpi, ok := lom.md.copies[copyFQN]
+		if !ok {
+			return nil, fmt.Errorf("lom %s(num: %d): copy %s does not exist", lom, numCopies, copyFQN)
 		}
+		mpaths[i] = mpi.Path
 		lom.delCopyMd(copyFQN)
 	}
 
 	// 2. Update metadata on remaining copies, if any
 	if err := lom.syncMetaWithCopies(); err != nil {
 		debug.AssertNoErr(err)
-		return err
+		return nil, err
 	}
 
-	// 3. Remove the copies
-	for _, copyFQN := range copiesFQN {
-		if err1 := cos.RemoveFile(copyFQN); err1 != nil {
-			glog.Error(err1) // TODO: LRU should take care of that later.
-			continue
+	// 3. Remove the copies (or keep them as is)
+	for i, copyFQN := range copiesFQN {
+		if keep {
+			detached = append(detached, copyFQN)
+		} else if err1 := rmOrphan(copyFQN); err1 != nil {
+			glog.Errorf("%s: %v - queued for cleanup", lom, err1) // (no longer a copy - see ReclaimOrphan)
 		}
+		fs.DecCopies(mpaths[i])
 	}
 	return
 }
@@ -114,244 +566,1222 @@ func (lom *LOM) DelAllCopies() (err error) {
 	return lom.DelCopies(copiesFQN...)
 }
 
-// DelExtraCopies deletes obj replicas that are not part of the lom.md.copies metadata
-// (cleanup)
-func (lom *LOM) DelExtraCopies(fqn ...string) (removed bool, err error) {
-	if lom.whingeCopy() {
+// DelAllCopiesBatch removes all copies of the given objects (e.g., when disabling mirroring);
+// for each object, the outcome is the same as w-lock => DelAllCopies => Persist => unlock, except that:
+// - objects are processed in batches with the copies removed in parallel across mountpaths;
+// - objects that cannot be w-locked right away are processed one by one upon their respective batch;
+// - cancellation stops (between batches) and returns ctx.Err() along with the number removed so far.
+// Returns the number of removed copies and the first error (if any) annotated with the total error count.
+func DelAllCopiesBatch(ctx context.Context, loms []*LOM) (removed int, err error) {
+	var (
+		b    = delBatch{byMpath: make(map[string][]string, 4)}
+		seen = make(cos.StrSet, cos.Min(len(loms), delBatchSize))
+	)
+	for i := 0; i < len(loms); i += delBatchSize {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		batch := loms[i:cos.Min(i+delBatchSize, len(loms))]
+		b.locked, b.busy = b.locked[:0], b.busy[:0]
+		for _, lom := range batch {
+			if seen.Contains(lom.Uname()) {
+				continue
+			}
+			seen.Add(lom.Uname())
+			if lom.TryLock(true) {
+				b.locked = append(b.locked, lom)
+				b.detach(lom)
+			} else {
+				b.busy = append(b.busy, lom)
+			}
+		}
+		b.removeAll()
+		for _, lom := range b.locked {
+			lom.Unlock(true)
+		}
+		// one at a time
+		for _, lom := range b.busy {
+			lom.Lock(true)
+			b.detach(lom)
+			b.removeAll()
+			lom.Unlock(true)
+		}
+		for k := range seen {
+			delete(seen, k)
+		}
+	}
+	removed = b.removed
+	if err == nil {
+		err = b.first()
+	}
+	return
+}
+
+// first error and the total count (shared by batch operations)
+type batchErrs struct {
+	err  error
+	nerr int
+	mu   sync.Mutex
+}
+
+func (b *batchErrs) addErr(err error) {
+	b.mu.Lock()
+	if b.err == nil {
+		b.err = err
+	}
+	b.nerr++
+	b.mu.Unlock()
+}
+
+func (b *batchErrs) first() error {
+	if b.nerr > 1 {
+		return fmt.Errorf("%w (and %d more error%s)", b.err, b.nerr-1, cos.Plural(b.nerr-1))
+	}
+	return b.err
+}
+
+type delBatch struct {
+	byMpath map[string][]string // copy FQNs to remove
+	locked  []*LOM
+	busy    []*LOM
+	removed int
+	batchErrs
+}
+
+// w-locked: update metadata (same as DelCopies) and schedule removal
+func (b *delBatch) detach(lom *LOM) {
+	lom.Uncache(false /*delDirty*/)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if !cmn.IsErrObjNought(err) {
+			b.addErr(err)
+		}
 		return
 	}
-	availablePaths := fs.GetAvail()
-	for _, mi := range availablePaths {
-		copyFQN := mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
-		if _, ok := lom.md.copies[copyFQN]; ok {
-			continue
+	if !lom.HasCopies() || lom.whingeCopy() {
+		return
+	}
+	var (
+		copies    = lom.md.copies
+		copiesFQN = make([]string, 0, len(copies)-1)
+	)
+	for copyFQN := range copies {
+		if copyFQN != lom.FQN {
+			copiesFQN = append(copiesFQN, copyFQN)
 		}
-		if err1 := cos.RemoveFile(copyFQN); err1 != nil {
-			err = err1
+	}
+	mpaths := make([]string, len(copiesFQN))
+	for i, copyFQN := range copiesFQN {
+		mpaths[i] = copies[copyFQN].Path
+		lom.delCopyMd(copyFQN)
+	}
+	if err := lom.syncMetaWithCopies(); err != nil {
+		b.addErr(err)
+		return
+	}
+	if err := lom.Persist(); err != nil {
+		b.addErr(err)
+		return
+	}
+	for i, copyFQN := range copiesFQN {
+		b.byMpath[mpaths[i]] = append(b.byMpath[mpaths[i]], copyFQN)
+	}
+}
+
+// remove scheduled copies - one goroutine per mountpath
+func (b *delBatch) removeAll() {
+	if len(b.byMpath) == 0 {
+		return
+	}
+	wg := &sync.WaitGroup{}
+	for mpath, fqns := range b.byMpath {
+		wg.Add(1)
+		go b.remove(mpath, fqns, wg)
+		delete(b.byMpath, mpath)
+	}
+	wg.Wait()
+}
+
+func (b *delBatch) remove(mpath string, fqns []string, wg *sync.WaitGroup) {
+	var n int
+	for _, copyFQN := range fqns {
+		// (no longer a copy either way - ditto DelCopies)
+		fs.DecCopies(mpath)
+		if err := rmOrphan(copyFQN); err != nil {
+			glog.Errorf("%s: %v - queued for cleanup", copyFQN, err)
 			continue
 		}
-		if len(fqn) > 0 && fqn[0] == copyFQN {
-			removed = true
+		n++
+	}
+	b.mu.Lock()
+	b.removed += n
+	b.mu.Unlock()
+	wg.Done()
+}
+
+// SyncCopiesMeta persists the (in-memory) metadata of the given objects and all their copies;
+// for each object, the outcome is the same as w-lock => syncMetaWithCopies => Persist => unlock, except that:
+// - the metadata is marshaled once per object, and copies are updated in parallel across mountpaths;
+// - objects that cannot be w-locked right away are processed one by one upon their respective batch;
+// - deferred write policy (see apc.WriteDelayed) is honored - metadata is marked dirty, not written.
+// NOTE: the caller must have loaded (and modified) the metadata - it is not reloaded here.
+// Returns the first error (if any) annotated with the total error count.
+func SyncCopiesMeta(loms []*LOM) error {
+	var (
+		b    = syncBatch{byMpath: make(map[string][]syncCopy, 4)}
+		seen = make(cos.StrSet, cos.Min(len(loms), delBatchSize))
+	)
+	for i := 0; i < len(loms); i += delBatchSize {
+		batch := loms[i:cos.Min(i+delBatchSize, len(loms))]
+		b.locked, b.busy = b.locked[:0], b.busy[:0]
+		for _, lom := range batch {
+			if seen.Contains(lom.Uname()) {
+				continue
+			}
+			seen.Add(lom.Uname())
+			if lom.TryLock(true) {
+				b.locked = append(b.locked, lom)
+				b.schedule(lom)
+			} else {
+				b.busy = append(b.busy, lom)
+			}
+		}
+		b.run()
+		for _, lom := range b.locked {
+			lom.Unlock(true)
+		}
+		// one at a time
+		for _, lom := range b.busy {
+			lom.Lock(true)
+			b.schedule(lom)
+			b.run()
+			lom.Unlock(true)
+		}
+		for k := range seen {
+			delete(seen, k)
 		}
 	}
-	return
+	return b.first()
 }
 
-// syncMetaWithCopies tries to make sure that all copies have identical metadata.
-// NOTE: uname for LOM must be already locked.
-// NOTE: changes _may_ be made - the caller must call lom.Persist() upon return
-func (lom *LOM) syncMetaWithCopies() (err error) {
-	var copyFQN string
-	if !lom.HasCopies() {
-		return nil
+type (
+	syncBatch struct {
+		byMpath map[string][]syncCopy // copies to update
+		pending []*syncMd
+		locked  []*LOM
+		busy    []*LOM
+		batchErrs
 	}
-	// NOTE: caller is responsible for write-locking
-	debug.AssertFunc(func() bool {
-		_, exclusive := lom.IsLocked()
-		return exclusive
-	})
-	if !lom.WritePolicy().IsImmediate() {
-		lom.md.makeDirty()
-		return nil
+	syncMd struct {
+		lom    *LOM
+		mm     *memsys.MMSA
+		buf    []byte   // marshaled once
+		failed []string // copies that failed to update
+	}
+	syncCopy struct {
+		md  *syncMd
+		fqn string
+	}
+)
+
+// w-locked: marshal and schedule copies for update
+func (b *syncBatch) schedule(lom *LOM) {
+	if !lom.HasCopies() || !lom.WritePolicy().IsImmediate() {
+		// nothing to replicate or deferred - same as the single-object path
+		if err := lom.syncMetaWithCopies(); err != nil {
+			b.addErr(err)
+			return
+		}
+		if err := lom.Persist(); err != nil {
+			b.addErr(err)
+		}
+		return
+	}
+	md := &syncMd{lom: lom}
+	md.buf, md.mm = lom.marshal()
+	for copyFQN, mi := range lom.md.copies {
+		if copyFQN != lom.FQN {
+			b.byMpath[mi.Path] = append(b.byMpath[mi.Path], syncCopy{md: md, fqn: copyFQN})
+		}
+	}
+	b.pending = append(b.pending, md)
+}
+
+// update scheduled copies (one goroutine per mountpath), drop those that fail, and persist
+func (b *syncBatch) run() {
+	if len(b.byMpath) > 0 {
+		wg := &sync.WaitGroup{}
+		for mpath, copies := range b.byMpath {
+			wg.Add(1)
+			go b.update(copies, wg)
+			delete(b.byMpath, mpath)
+		}
+		wg.Wait()
+	}
+	for _, md := range b.pending {
+		lom := md.lom
+		md.mm.Free(md.buf)
+		if len(md.failed) > 0 {
+			for _, copyFQN := range md.failed {
+				if mpi, ok := lom.md.copies[copyFQN]; ok {
+					fs.DecCopies(mpi.Path)
+				}
+				lom.delCopyMd(copyFQN)
+			}
+			// (the number of copies has changed - ditto persistCopies)
+			lom.persistCopies()
+		}
+		if err := lom.Persist(); err != nil {
+			b.addErr(err)
+		}
+	}
+	b.pending = b.pending[:0]
+}
+
+func (b *syncBatch) update(copies []syncCopy, wg *sync.WaitGroup) {
+	for _, c := range copies {
+		err := fs.SetXattr(c.fqn, XattrLOM, c.md.buf)
+		if err == nil {
+			continue
+		}
+		if err1 := cos.Stat(c.fqn); err1 != nil && !os.IsNotExist(err1) {
+			T.FSHC(err, c.fqn)
+		}
+		b.mu.Lock()
+		c.md.failed = append(c.md.failed, c.fqn)
+		b.mu.Unlock()
+	}
+	wg.Done()
+}
+
+// DelExtraCopies deletes obj replicas that are not part of the lom.md.copies metadata
+// (cleanup); those that fail to be removed are queued (see QueueOrphan)
+func (lom *LOM) DelExtraCopies(fqn ...string) (removed bool, err error) {
+	if lom.whingeCopy() {
+		return
+	}
+	availablePaths := fs.GetAvail()
+	for _, mi := range availablePaths {
+		copyFQN := mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+		if _, ok := lom.md.copies[copyFQN]; ok {
+			continue
+		}
+		if err1 := rmOrphan(copyFQN); err1 != nil {
+			err = err1
+			continue
+		}
+		if len(fqn) > 0 && fqn[0] == copyFQN {
+			removed = true
+		}
+	}
+	return
+}
+
+// syncMetaWithCopies tries to make sure that all copies have identical metadata.
+// NOTE: uname for LOM must be already locked.
+// NOTE: changes _may_ be made - the caller must call lom.Persist() upon return
+func (lom *LOM) syncMetaWithCopies() (err error) {
+	if !lom.HasCopies() {
+		return nil
+	}
+	// NOTE: caller is responsible for write-locking
+	debug.AssertFunc(func() bool {
+		_, exclusive := lom.IsLocked()
+		return exclusive
+	})
+	if !lom.WritePolicy().IsImmediate() {
+		lom.md.makeDirty()
+		return nil
+	}
+	lom.persistCopies()
+	return
+}
+
+// replicate metadata across copies while dropping those that fail
+func (lom *LOM) persistCopies() {
+	for {
+		copyFQN, err := lom.persistMdOnCopies()
+		if err == nil {
+			break
+		}
+		if mpi, ok := lom.md.copies[copyFQN]; ok && copyFQN != lom.FQN {
+			fs.DecCopies(mpi.Path)
+		}
+		lom.delCopyMd(copyFQN)
+		if err1 := cos.Stat(copyFQN); err1 != nil && !os.IsNotExist(err1) {
+			T.FSHC(err, copyFQN) // TODO: notify scrubber
+		}
+	}
+}
+
+// FlushCopyMd persists (deferred, see apc.WriteDelayed) metadata of the object and all its copies
+// immediately and regardless of the configured write policy - e.g., prior to graceful shutdown.
+// Is a no-op when the object has no copies or its metadata is not dirty.
+func (lom *LOM) FlushCopyMd() (err error) {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err = lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		return
+	}
+	if !lom.HasCopies() || !lom.md.isDirty() {
+		return
+	}
+	lom.persistCopies()
+	buf, mm := lom.marshal()
+	if err = fs.SetXattr(lom.FQN, XattrLOM, buf); err != nil {
+		T.FSHC(err, lom.FQN)
+	} else {
+		lom.md.clearDirty()
+		if !lom.IsCopy() {
+			lom.Recache()
+		}
+	}
+	mm.Free(buf)
+	return
+}
+
+// RestoreToLocation tries to restore the object at its default location.
+// Returns true if object exists, false otherwise
+// TODO: locking vs concurrent restore: consider (read-lock object + write-lock meta) split
+func (lom *LOM) RestoreToLocation() (exists bool) {
+	lom.Lock(true)
+	exists = lom.restoreLocked()
+	lom.Unlock(true)
+	return
+}
+
+// TryRestoreToLocation is a non-blocking variant of the above that returns right away
+// (with started == false) if the object is locked by (e.g.) another restoring goroutine
+func (lom *LOM) TryRestoreToLocation() (started, exists bool) {
+	if !lom.TryLock(true) {
+		return
+	}
+	started, exists = true, lom.restoreLocked()
+	lom.Unlock(true)
+	return
+}
+
+func (lom *LOM) restoreLocked() (exists bool) {
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err == nil {
+		return true // nothing to do
+	}
+	var (
+		saved          = lom.md.pushrt()
+		availablePaths = fs.GetAvail()
+		buf, slab      = T.PageMM().Alloc()
+		fqns           = make([]string, 0, len(availablePaths))
+	)
+	for path, mi := range availablePaths {
+		if path == lom.mpathInfo.Path {
+			continue
+		}
+		fqn := mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+		if err := cos.Stat(fqn); err != nil {
+			continue
+		}
+		fqns = append(fqns, fqn)
+	}
+	if len(fqns) > 1 && cmn.Features.IsSet(feat.VerifyRestoreSources) {
+		fqns = lom.verifySources(fqns, buf)
+	}
+	for _, fqn := range fqns {
+		dst, err := lom.restoreFrom(fqn, buf)
+		if err == nil {
+			lom.md = dst.md
+			exists = true
+			FreeLOM(dst)
+			break
+		}
+		if dst != nil {
+			FreeLOM(dst)
+		}
+	}
+	lom.md.poprt(saved)
+	slab.Free(buf)
+	return
+}
+
+// reads every candidate source in its entirety to recompute its checksum and compare it with the
+// expected one: the object's own, when known, or else the one stored with the candidate itself.
+// Returns the candidates that verify, followed by those that cannot be verified (no checksum);
+// drops the ones that fail to load or verify.
+func (lom *LOM) verifySources(fqns []string, buf []byte) (sources []string) {
+	var (
+		expected = lom.md.Cksum
+		unknown  []string
+	)
+	sources = make([]string, 0, len(fqns))
+	for _, fqn := range fqns {
+		src := AllocLOM(lom.ObjName)
+		if err := src.InitFQN(fqn, lom.Bucket()); err != nil {
+			FreeLOM(src)
+			continue
+		}
+		if err := src.Load(false /*cache it*/, true /*locked*/); err != nil {
+			glog.Warningf("%s: skipping restore source %q: %v", lom, fqn, err)
+			FreeLOM(src)
+			continue
+		}
+		cksum := expected
+		if cksum.IsEmpty() {
+			cksum = src.md.Cksum
+		}
+		FreeLOM(src)
+		if cksum.IsEmpty() {
+			unknown = append(unknown, fqn)
+			continue
+		}
+		computed, err := cos.ChecksumFile(fqn, cksum.Ty(), buf)
+		if err != nil {
+			glog.Warningf("%s: skipping restore source %q: %v", lom, fqn, err)
+			continue
+		}
+		if !computed.Equal(cksum) {
+			glog.Errorf("%s: skipping restore source %q: %v", lom, fqn,
+				cos.NewBadDataCksumError(&computed.Cksum, cksum, fqn))
+			continue
+		}
+		sources = append(sources, fqn)
+	}
+	return append(sources, unknown...)
+}
+
+// retry the same source upon transient errors (EBUSY, short write, etc. - see cos.IsIOError)
+// with linear backoff; not-found and corrupted sources are not retried.
+// The object remains w-locked throughout, backoff included.
+func (lom *LOM) restoreFrom(fqn string, buf []byte) (dst *LOM, err error) {
+	for retry := 1; ; retry++ {
+		dst, err = lom._restore(fqn, buf)
+		if err == nil || !cos.IsIOError(err) || retry > maxRestoreRetries {
+			return
+		}
+		if dst != nil {
+			FreeLOM(dst)
+			dst = nil
+		}
+		glog.Warningf("%s: failed to restore from %q (%v) - retrying (%d/%d)...", lom, fqn, err, retry, maxRestoreRetries)
+		time.Sleep(restoreRetryWait * time.Duration(retry))
+	}
+}
+
+func (lom *LOM) _restore(fqn string, buf []byte) (dst *LOM, err error) {
+	src := lom.CloneMD(fqn)
+	defer FreeLOM(src)
+	if err = src.InitFQN(fqn, lom.Bucket()); err != nil {
+		return
+	}
+	if err = src.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return
+	}
+	// restore at default location
+	if dst, err = src.Copy2FQN(lom.FQN, buf); err != nil {
+		// (e.g., bad checksum: the corrupted copy may have been already renamed to its default location)
+		if errRemove := cos.RemoveFile(lom.FQN); errRemove != nil {
+			glog.Errorf(fmtNestedErr, errRemove)
+		}
+		return
+	}
+	// unless the bucket is configured with no checksumming, re-read (and recompute)
+	// the restored object to validate it against its stored checksum
+	if err = dst.ValidateContentChecksum(); err != nil {
+		glog.Errorf("%s: failed to restore from %q: %v", lom, fqn, err)
+		if errRemove := cos.RemoveFile(lom.FQN); errRemove != nil {
+			glog.Errorf(fmtNestedErr, errRemove)
+		}
+	}
+	return
+}
+
+// increment the object's num copies by (well) copying the former
+// (compare with lom.Copy2FQN below)
+func (lom *LOM) Copy(mi *fs.MountpathInfo, buf []byte) (err error) {
+	copyFQN := mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+	return lom.copyTo(mi, copyFQN, buf)
+}
+
+// same as Copy() above but with the destination specified by its FQN.
+// If the latter parses (see fs.ParseFQN) as this same object (same bucket, same name)
+// on an available mountpath that does not yet store the object or any of its copies,
+// the copy gets registered in the object's metadata (md.copies) as a mirror.
+// Any other (non-object) destination, e.g. outside mountpaths, receives a plain copy
+// that is written via a work file and renamed into place - not registered, not counted.
+// Locations of other objects, including the object itself, are rejected.
+// NOTE: `lom` must be w-locked
+func (lom *LOM) CopyToFQN(copyFQN string, buf []byte) error {
+	if copyFQN == lom.FQN {
+		return fmt.Errorf("%s: copy destination %q is the object itself", lom, copyFQN)
+	}
+	parsed, err := fs.ParseFQN(copyFQN)
+	if err != nil || parsed.ContentType != fs.ObjectType {
+		return lom.copyToPath(copyFQN, buf)
+	}
+	if parsed.ObjName != lom.ObjName || !parsed.Bck.Equal(lom.Bucket()) {
+		return fmt.Errorf("%s: copy destination %q resolves to a different object %s",
+			lom, copyFQN, filepath.Join(parsed.Bck.String(), parsed.ObjName))
+	}
+	mi, ok := fs.GetAvail()[parsed.MpathInfo.Path]
+	if !ok || mi.IsAnySet(fs.FlagWaitingDD) {
+		return fmt.Errorf("%s: copy destination %q: mountpath %s is not available", lom, copyFQN, parsed.MpathInfo)
+	}
+	if lom.haveMpath(mi.Path) {
+		return fmt.Errorf("%s: copy destination %q: mountpath %s already has a copy", lom, copyFQN, mi)
+	}
+	return lom.copyTo(mi, copyFQN, buf)
+}
+
+// copy content to an arbitrary location (see CopyToFQN) - not a mirror
+func (lom *LOM) copyToPath(dstFQN string, buf []byte) (err error) {
+	var workFQN string
+	if mi, _, errMpath := fs.FQN2Mpath(dstFQN); errMpath == nil {
+		if err = checkSpace(mi, lom.SizeBytes()); err != nil {
+			return
+		}
+		workFQN = mi.MakePathFQN(lom.Bucket(), fs.WorkfileType, fs.WorkfileCopy+"."+lom.ObjName)
+	} else {
+		workFQN = dstFQN + "." + fs.WorkfileCopy + "." + cos.GenTie()
+	}
+	if _, err = copyFile(lom.FQN, workFQN, buf, cos.ChecksumNone); err != nil {
+		return
+	}
+	if err = cos.Rename(workFQN, dstFQN); err != nil {
+		if errRemove := cos.RemoveFile(workFQN); errRemove != nil {
+			glog.Errorf(fmtNestedErr, errRemove)
+		}
+	}
+	return
+}
+
+func (lom *LOM) copyTo(mi *fs.MountpathInfo, copyFQN string, buf []byte) (err error) {
+	var copied bool
+	workFQN := mi.MakePathFQN(lom.Bucket(), fs.WorkfileType, fs.WorkfileCopy+"."+lom.ObjName)
+	// check if the copy destination exists and then skip copying if it's also identical
+	if finfo, errExists := os.Stat(copyFQN); errExists == nil {
+		cplom := AllocLOM(lom.ObjName)
+		defer FreeLOM(cplom)
+		if errExists = cplom.InitFQN(copyFQN, lom.Bucket()); errExists == nil {
+			errExists = cplom.Load(false /*cache it*/, true /*locked*/)
+		}
+		if errExists == nil {
+			if lom.sameSizeMtime(finfo) && lom.sameCksumVer(cplom) {
+				goto add
+			}
+			if cplom.Equal(lom) && lom.sameContent(cplom, buf) {
+				lom.carryMtime(copyFQN) // next time, take the fast path
+				goto add
+			}
+		}
+	}
+
+	// copy
+	if err = checkSpace(mi, lom.SizeBytes()); err != nil {
+		return
+	}
+	_, err = copyFile(lom.FQN, workFQN, buf, cos.ChecksumNone) // TODO: checksumming (other than lazy)
+	if err != nil {
+		return
+	}
+	lom.carryMtime(workFQN)
+	if err = cos.Rename(workFQN, copyFQN); err != nil {
+		if errRemove := cos.RemoveFile(workFQN); errRemove != nil {
+			glog.Errorf(fmtNestedErr, errRemove)
+		}
+		return
+	}
+	copied = true
+add:
+	// add md and persist
+	_, had := lom.md.copies[copyFQN]
+	lom.AddCopy(copyFQN, mi)
+	err = lom.Persist()
+	if err != nil {
+		if !had {
+			lom.delCopyMd(copyFQN)
+		}
+		glog.Error(err)
+		return err
+	}
+	if !had {
+		fs.IncCopies(mi.Path)
+	}
+	if err = lom.syncMetaWithCopies(); err == nil && copied {
+		if cksum := lom.Checksum(); lom.MirrorConf().LazyCksum && !cksum.IsEmpty() && lazyCksumAdmit() {
+			lom.lazyCksum(copyFQN, cksum)
+		}
+	}
+	return
+}
+
+// copy object => any local destination
+// recommended for copying between different buckets (compare with lom.Copy() above);
+// cross-bucket, when the source has no checksum the destination gets one of its own
+// bucket's configured type (if any); otherwise, the copy is verified against the source
+// checksum - unless both buckets share the checksum type and feat.TrustCopyChecksum is set;
+// an existing destination gets overwritten (see Copy2FQNMode)
+// NOTE: `lom` source must be w-locked
+func (lom *LOM) Copy2FQN(dstFQN string, buf []byte) (dst *LOM, err error) {
+	return lom.Copy2FQNMode(dstFQN, buf, CollOverwrite)
+}
+
+// same as above with a given way to handle an existing destination (e.g., for idempotent migration):
+// - CollOverwrite: overwrite it;
+// - CollSkip: leave it intact and return cmn.ErrSkip (and no destination) - not an error;
+// - CollFail: leave it intact and fail with cmn.ErrObjExists.
+// The destination's existence is checked prior to copying and, again, prior to committing (renaming)
+// the copy in place.
+func (lom *LOM) Copy2FQNMode(dstFQN string, buf []byte, mode Collision) (dst *LOM, err error) {
+	dst = lom.CloneMD(dstFQN)
+	if err = dst.InitFQN(dstFQN, nil); err == nil {
+		if err = dst.collide(mode); err == nil {
+			err = lom.copy2fqn(dst, buf, mode)
+		}
+	}
+	if err != nil {
+		FreeLOM(dst)
+		dst = nil
+	}
+	return
+}
+
+// returns nil when the (copy) destination can be written, cmn.ErrSkip or cmn.ErrObjExists otherwise
+func (lom *LOM) collide(mode Collision) error {
+	if mode == CollOverwrite {
+		return nil
+	}
+	if err := cos.Stat(lom.FQN); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if mode == CollSkip {
+		return cmn.ErrSkip
+	}
+	return cmn.NewErrObjExists(lom.String())
+}
+
+func (lom *LOM) copy2fqn(dst *LOM, buf []byte, mode Collision) (err error) {
+	var (
+		dstCksum  *cos.CksumHash
+		dstFQN    = dst.FQN
+		srcCksum  = lom.Checksum()
+		cksumType = cos.ChecksumNone
+	)
+	if !srcCksum.IsEmpty() {
+		cksumType = srcCksum.Ty()
+	}
+	crossBck := !dst.Bck().Equal(lom.Bck(), true /*same ID*/, true /*same backend*/)
+	// mirror copy (but not restoring the object at its default location): optionally, skip
+	// inline checksumming and verify the copy later, in the background (see lcksum.go)
+	if lom.isMirror(dst) && dstFQN != dst.HrwFQN && !srcCksum.IsEmpty() &&
+		lom.MirrorConf().LazyCksum && lazyCksumAdmit() {
+		cksumType = cos.ChecksumNone
+		defer func() {
+			if err == nil {
+				lom.lazyCksum(dstFQN, srcCksum)
+			} else {
+				cksumPending.Dec()
+			}
+		}()
+	}
+	if !srcCksum.IsEmpty() && !lom.isMirror(dst) && srcCksum.Ty() == dst.CksumType() &&
+		cmn.Features.IsSet(feat.TrustCopyChecksum) {
+		// same checksum type at both ends: copy bytes and trust the source's stored
+		// checksum (already carried over by CloneMD) - no recomputing, no verification
+		cksumType = cos.ChecksumNone
+	}
+	if crossBck && srcCksum.IsEmpty() {
+		// source has no checksum: protect the destination as per its bucket's
+		// configuration (computing it on the fly, in a single pass)
+		if ty := dst.CksumType(); ty != "" {
+			cksumType = ty
+		}
+	}
+	if dst.isMirror(lom) && lom.md.copies != nil {
+		dst.md.copies = make(fs.MPI, len(lom.md.copies)+1)
+		for fqn, mpi := range lom.md.copies {
+			dst.md.copies[fqn] = mpi
+		}
+	}
+	if crossBck {
+		// The copy will be in a new bucket - completely separate object. Hence, we have to set initial version.
+		dst.SetVersion(lomInitialVersion)
+	}
+
+	if err = checkSpace(dst.mpathInfo, lom.SizeBytes()); err != nil {
+		return
+	}
+	workFQN := fs.CSM.Gen(dst, fs.WorkfileType, fs.WorkfileCopy)
+	dstCksum, err = copyFile(lom.FQN, workFQN, buf, cksumType)
+	if err != nil {
+		return
+	}
+
+	if err = dst.collide(mode); err != nil {
+		if errRemove := cos.RemoveFile(workFQN); errRemove != nil {
+			glog.Errorf(fmtNestedErr, errRemove)
+		}
+		return
+	}
+	if err = cos.Rename(workFQN, dstFQN); err != nil {
+		if errRemove := cos.RemoveFile(workFQN); errRemove != nil {
+			glog.Errorf(fmtNestedErr, errRemove)
+		}
+		return
+	}
+
+	if cksumType != cos.ChecksumNone {
+		if !srcCksum.IsEmpty() && !dstCksum.Equal(srcCksum) {
+			return cos.NewBadDataCksumError(&dstCksum.Cksum, srcCksum)
+		}
+		dst.SetCksum(dstCksum.Clone())
+	}
+
+	// persist
+	if lom.isMirror(dst) {
+		if lom.md.copies == nil {
+			lom.md.copies = make(fs.MPI, 2)
+			dst.md.copies = make(fs.MPI, 2)
+		}
+		// a new copy unless already registered or restoring the object at its default location
+		_, had := lom.md.copies[dstFQN]
+		isNew := !had && dstFQN != dst.HrwFQN
+		lom.md.copies[dstFQN], dst.md.copies[dstFQN] = dst.mpathInfo, dst.mpathInfo
+		lom.md.copies[lom.FQN], dst.md.copies[lom.FQN] = lom.mpathInfo, lom.mpathInfo
+		if isNew {
+			fs.IncCopies(dst.mpathInfo.Path) // (persistCopies decrements when dropping)
+		}
+		if err = lom.syncMetaWithCopies(); err != nil {
+			if _, ok := lom.md.copies[dst.FQN]; !ok {
+				if errRemove := os.Remove(dst.FQN); errRemove != nil {
+					glog.Errorf("nested err: %v", errRemove)
+				}
+			}
+			// `lom.syncMetaWithCopies()` may have made changes notwithstanding
+			if errPersist := lom.Persist(); errPersist != nil {
+				glog.Errorf("nested err: %v", errPersist)
+			}
+			return
+		}
+		err = lom.Persist()
+	} else if err = dst.Persist(); err != nil {
+		if errRemove := os.Remove(dst.FQN); errRemove != nil {
+			glog.Errorf("nested err: %v", errRemove)
+		}
+	}
+	return
+}
+
+// write object => any local destination, sourcing the content from a given reader
+// (e.g., transformed or received over network) rather than `lom.FQN` (compare with Copy2FQN);
+// - `lom` provides the destination's metadata; when it has a checksum of the same type
+// the written content must match it (cos.ErrBadCksum otherwise);
+// - empty `cksumType` defaults to the one configured for the destination bucket;
+// - the content is written into a workfile that then gets renamed - no partial writes
+// NOTE: `lom` must be w-locked
+func (lom *LOM) WriteToFQN(dstFQN string, r io.Reader, cksumType string, buf []byte) (dst *LOM, err error) {
+	dst = lom.CloneMD(dstFQN)
+	if err = dst.InitFQN(dstFQN, nil); err == nil {
+		err = lom.write2fqn(dst, r, cksumType, buf)
+	}
+	if err != nil {
+		FreeLOM(dst)
+		dst = nil
+	}
+	return
+}
+
+func (lom *LOM) write2fqn(dst *LOM, r io.Reader, cksumType string, buf []byte) (err error) {
+	var (
+		wfh      *os.File
+		size     int64
+		dstCksum *cos.CksumHash
+		srcCksum = lom.Checksum()
+		workFQN  = fs.CSM.Gen(dst, fs.WorkfileType, fs.WorkfilePut)
+	)
+	if cksumType == "" {
+		cksumType = dst.CksumType()
+	}
+	if !dst.Bck().Equal(lom.Bck(), true /*same ID*/, true /*same backend*/) {
+		dst.SetVersion(lomInitialVersion)
+	}
+	if wfh, err = cos.CreateFile(workFQN); err != nil {
+		return
+	}
+	size, dstCksum, err = cos.CopyAndChecksum(wfh, r, buf, cksumType)
+	if err == nil {
+		err = cos.FlushClose(wfh)
+	} else {
+		cos.Close(wfh)
+	}
+	if err == nil && dstCksum != nil && !srcCksum.IsEmpty() && srcCksum.Ty() == dstCksum.Ty() {
+		if !dstCksum.Equal(srcCksum) {
+			err = cos.NewBadDataCksumError(&dstCksum.Cksum, srcCksum)
+		}
+	}
+	if err == nil {
+		err = cos.Rename(workFQN, dst.FQN)
+	}
+	if err != nil {
+		if errRemove := cos.RemoveFile(workFQN); errRemove != nil {
+			glog.Errorf(fmtNestedErr, errRemove)
+		}
+		return
+	}
+
+	dst.SetSize(size)
+	if dstCksum != nil {
+		dst.SetCksum(dstCksum.Clone())
+	} else {
+		dst.SetCksum(cos.NoneCksum)
+	}
+	if err = dst.Persist(); err != nil {
+		if errRemove := cos.RemoveFile(dst.FQN); errRemove != nil {
+			glog.Errorf(fmtNestedErr, errRemove)
+		}
+	}
+	return
+}
+
+// load-balanced GET
+func (lom *LOM) LBGet() (fqn string) { return lom.LBGetFor(0) }
+
+// same as above, with the (expected) size of the read: large (sequential) reads
+// additionally take into account write load and free space of the copies' mountpaths
+// (see leastLoadedCopy); with load balancing disabled (mirror.disable_lb_get), always
+// returns the default (HRW) location
+func (lom *LOM) LBGetFor(sizeHint int64) (fqn string) {
+	mirror := lom.MirrorConf()
+	if mirror.HealOnGet && mirror.Enabled && int64(lom.NumCopies()) < lom.MirrorCopies() {
+		lom.healAsync()
+	}
+	if !lom.HasCopies() || mirror.DisableLBGet {
+		return lom.FQN
+	}
+	if sizeHint < lbLargeRead {
+		return lom.leastUtilCopy()
+	}
+	return lom.leastLoadedCopy()
+}
+
+// copy-on-read: best-effort and non-blocking (as far as the GET in progress) replication
+// of an under-replicated object (see MirrorConf.HealOnGet)
+func (lom *LOM) healAsync() {
+	if n := numHealing.Inc(); n > maxHealing {
+		numHealing.Dec()
+		return
+	}
+	bck := *lom.Bucket()
+	go healCopies(&bck, lom.ObjName)
+}
+
+func healCopies(bck *cmn.Bck, objName string) {
+	defer numHealing.Dec()
+	lom := AllocLOM(objName)
+	defer FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return
+	}
+	buf, slab := T.PageMM().Alloc()
+	if _, err := lom.EnsureCopies(buf); err != nil && !cmn.IsErrObjNought(err) {
+		glog.Errorf("%s: failed to add copy on GET: %v", lom, err)
+	}
+	slab.Free(buf)
+}
+
+// EnsureCopies brings the number of replicas up to the configured `mirror.copies`
+// (or the per-object override - see SetMirrorCopies):
+// - takes w-lock and reloads metadata;
+// - is a no-op when mirroring is disabled or the object has enough copies already;
+// - does not remove extra copies (see mirror package for that);
+// - not having enough mountpaths is not an error (warning)
+func (lom *LOM) EnsureCopies(buf []byte) (created int, err error) {
+	lom.Lock(true) // (may wait for a GET in progress to finish)
+	defer lom.Unlock(true)
+	lom.Uncache(false /*delDirty*/)
+	if err = lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return
+	}
+	if !lom.IsHRW() {
+		err = fmt.Errorf("%s: cannot replicate from a non-default location %q", lom, lom.FQN)
+		return
+	}
+	if !lom.MirrorConf().Enabled {
+		return
+	}
+	copies := lom.MirrorCopies()
+	for int64(lom.NumCopies()) < copies {
+		mi := lom.LeastUtilNoCopy()
+		if mi == nil {
+			glog.Warningf("%s: not enough mountpaths (%d) to place (%d/%d) copies",
+				lom, len(fs.GetAvail()), lom.NumCopies(), copies)
+			return
+		}
+		if err = lom.Copy(mi, buf); err != nil {
+			return
+		}
+		created++
+	}
+	return
+}
+
+// EvacuateCopiesFrom moves all copies off the given (e.g., draining - see FlagWaitingDD)
+// mountpath: for each such copy, first creates a replacement on the least utilized
+// healthy mountpath (see LeastUtilNoCopy) and only then removes the old one.
+// Not having a mountpath to move to is an error - the copy in question (and the
+// ones that follow) then stay in place.
+// NOTE: the object itself (ie., its default location) is not a copy and is not moved;
+// neither are pinned copies (see PinCopy)
+// NOTE: caller must take wlock and persist
+func (lom *LOM) EvacuateCopiesFrom(mpath string, buf []byte) (moved int, err error) {
+	if lom.whingeCopy() {
+		return 0, fmt.Errorf("%s: cannot evacuate copies from a non-default location %q", lom, lom.FQN)
+	}
+	fqns := make([]string, 0, 1)
+	for fqn, mpi := range lom.md.copies {
+		if mpi.Path == mpath && fqn != lom.FQN && !lom.IsPinned(fqn) {
+			fqns = append(fqns, fqn)
+		}
+	}
+	for _, fqn := range fqns {
+		mi := lom.LeastUtilNoCopy()
+		if mi == nil {
+			err = fmt.Errorf("%s: no mountpath to move copy %q to (available %d, num copies %d)",
+				lom, fqn, len(fs.GetAvail()), lom.NumCopies())
+			return
+		}
+		if err = lom.Copy(mi, buf); err != nil {
+			return
+		}
+		if err = lom.DelCopies(fqn); err != nil {
+			return
+		}
+		moved++
+	}
+	return
+}
+
+// RebalanceCopies relocates copies from the mountpaths that hold disproportionately many
+// copies (as per fs.CopyCountByMpath) to the ones that hold the fewest - steady-state skew
+// that accumulates over time and that placement (see LeastUtilNoCopy) does not undo:
+// - a copy moves only when the counts differ by 2 or more (strict improvement, no ping-pong);
+// - the new copy gets created first, and only then the old one is removed;
+// - the object itself (ie., its default location) and pinned copies (see PinCopy) stay in place;
+// - eligible destinations are the same as for new copies (see LeastUtilNoCopy).
+// NOTE: takes w-lock and reloads metadata (compare with EnsureCopies)
+func (lom *LOM) RebalanceCopies(buf []byte) (moved int, err error) {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	lom.Uncache(false /*delDirty*/)
+	if err = lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return
+	}
+	if !lom.IsHRW() {
+		err = fmt.Errorf("%s: cannot rebalance copies from a non-default location %q", lom, lom.FQN)
+		return
+	}
+	if !lom.HasCopies() {
+		return
 	}
-	for {
-		if copyFQN, err = lom.persistMdOnCopies(); err == nil {
+	var (
+		counts = fs.CopyCountByMpath()
+		fqns   = make([]string, 0, len(lom.md.copies))
+	)
+	for fqn, mpi := range lom.md.copies {
+		if _, ok := counts[mpi.Path]; ok && fqn != lom.FQN && !lom.IsPinned(fqn) {
+			fqns = append(fqns, fqn)
+		}
+	}
+	for _, fqn := range fqns {
+		var (
+			from   = lom.md.copies[fqn].Path
+			mi, mn = lom.fewestCopiesNoCopy(counts, from)
+		)
+		if mi == nil || counts[from]-mn < 2 {
+			continue
+		}
+		if err = lom.Copy(mi, buf); err != nil {
 			break
 		}
-		lom.delCopyMd(copyFQN)
-		if err1 := cos.Stat(copyFQN); err1 != nil && !os.IsNotExist(err1) {
-			T.FSHC(err, copyFQN) // TODO: notify scrubber
+		if err = lom.DelCopies(fqn); err != nil {
+			break
+		}
+		counts[mi.Path]++
+		counts[from]--
+		moved++
+	}
+	if moved > 0 {
+		if errPersist := lom.Persist(); errPersist != nil && err == nil {
+			err = errPersist
 		}
 	}
 	return
 }
 
-// RestoreObjectFromAny tries to restore the object at its default location.
-// Returns true if object exists, false otherwise
-// TODO: locking vs concurrent restore: consider (read-lock object + write-lock meta) split
-func (lom *LOM) RestoreToLocation() (exists bool) {
-	lom.Lock(true)
-	if err := lom.Load(true /*cache it*/, true /*locked*/); err == nil {
-		lom.Unlock(true)
-		return true // nothing to do
-	}
+// returns the eligible mountpath (see LeastUtilNoCopy) that does not have a copy of
+// this `lom` and has the fewest copies (of all objects) - along with the count itself;
+// never moves the copy (that's currently on the `from` mountpath) into a failure domain
+// that holds another one
+func (lom *LOM) fewestCopiesNoCopy(counts map[string]int64, from string) (mi *fs.MountpathInfo, minCnt int64) {
 	var (
-		saved          = lom.md.pushrt()
 		availablePaths = fs.GetAvail()
-		buf, slab      = T.PageMM().Alloc()
+		mirror         = lom.MirrorConf()
+		digest         = xxhash.ChecksumString64S(lom.md.uname, cos.MLCG32)
+		size           = lom.SizeBytes()
+		skipSpace      = cmn.Features.IsSet(feat.SkipCopySpaceCheck)
+		held           = lom.copyDomains(from)
+		maxCs          uint64
 	)
-	for path, mi := range availablePaths {
-		if path == lom.mpathInfo.Path {
+	for mpath, mpathInfo := range availablePaths {
+		if !mirror.MpathAllowed(mpath) || lom.haveMpath(mpath) || mpathInfo.IsAnySet(fs.FlagWaitingDD) {
 			continue
 		}
-		fqn := mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
-		if err := cos.Stat(fqn); err != nil {
+		if held[mpathInfo.Domain] {
 			continue
 		}
-		dst, err := lom._restore(fqn, buf)
-		if err == nil {
-			lom.md = dst.md
-			lom.md.poprt(saved)
-			exists = true
-			FreeLOM(dst)
-			break
+		if !skipSpace && !fitsCapacity(mpathInfo.GetCapacity(), size) {
+			continue
 		}
-		if dst != nil {
-			FreeLOM(dst)
+		cnt := counts[mpath]
+		if mi != nil && cnt > minCnt {
+			continue
+		}
+		cs := xoshiro256.Hash(mpathInfo.PathDigest ^ digest)
+		if mi == nil || cnt < minCnt || cs > maxCs {
+			mi, minCnt, maxCs = mpathInfo, cnt, cs
 		}
 	}
-	lom.Unlock(true)
-	slab.Free(buf)
 	return
 }
 
-func (lom *LOM) _restore(fqn string, buf []byte) (dst *LOM, err error) {
-	src := lom.CloneMD(fqn)
-	defer FreeLOM(src)
-	if err = src.InitFQN(fqn, lom.Bucket()); err != nil {
+// ReplaceWithCopies overwrites the object and all its copies with the content of `newFQN`
+// (a fully written workfile that must reside on the object's mountpath), in three steps:
+// copy the new content into a workfile next to each existing copy; update the object's
+// metadata (size, checksum, version) and write it into all the new files, so that each
+// is self-consistent; and finally, rename in place the object and then its copies.
+// Failure to prepare the copies or to rename the object rolls back: the object
+// and its copies remain intact, while `newFQN` is left for the caller to remove.
+// Past that point, a copy that fails to get renamed is removed and dropped from
+// metadata - upon success, none of the remaining copies has the old content.
+// NOTE: takes w-lock
+func (lom *LOM) ReplaceWithCopies(newFQN string, buf []byte) (err error) {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	lom.Uncache(false /*delDirty*/)
+	if err = lom.Load(false /*cache it*/, true /*locked*/); err != nil {
 		return
 	}
-	if err = src.Load(false /*cache it*/, true /*locked*/); err != nil {
+	if lom.whingeCopy() {
+		return fmt.Errorf("%s: cannot replace from a non-default location %q", lom, lom.FQN)
+	}
+	finfo, err := os.Stat(newFQN)
+	if err != nil {
 		return
 	}
-	// restore at default location
-	dst, err = src.Copy2FQN(lom.FQN, buf)
-	return
-}
-
-// increment the object's num copies by (well) copying the former
-// (compare with lom.Copy2FQN below)
-func (lom *LOM) Copy(mi *fs.MountpathInfo, buf []byte) (err error) {
 	var (
-		copyFQN = mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
-		workFQN = mi.MakePathFQN(lom.Bucket(), fs.WorkfileType, fs.WorkfileCopy+"."+lom.ObjName)
+		cksum     *cos.Cksum
+		saved     = lom.md
+		size      = finfo.Size()
+		cksumType = lom.CksumType()
+		works     = make(map[string]string, len(lom.md.copies)) // copy => workfile
 	)
-	// check if the copy destination exists and then skip copying if it's also identical
-	if errExists := cos.Stat(copyFQN); errExists == nil {
-		cplom := AllocLOM(lom.ObjName)
-		defer FreeLOM(cplom)
-		if errExists = cplom.InitFQN(copyFQN, lom.Bucket()); errExists == nil {
-			if errExists = cplom.Load(false /*cache it*/, true /*locked*/); errExists == nil && cplom.Equal(lom) {
-				goto add
+	// 1. prepare
+	rollback := func() {
+		lom.md = saved
+		for _, workFQN := range works {
+			if errRemove := cos.RemoveFile(workFQN); errRemove != nil {
+				glog.Errorf(fmtNestedErr, errRemove)
 			}
 		}
 	}
-
-	// copy
-	_, _, err = cos.CopyFile(lom.FQN, workFQN, buf, cos.ChecksumNone) // TODO: checksumming
-	if err != nil {
-		return
-	}
-	if err = cos.Rename(workFQN, copyFQN); err != nil {
-		if errRemove := cos.RemoveFile(workFQN); errRemove != nil {
-			glog.Errorf(fmtNestedErr, errRemove)
+	for copyFQN, mi := range lom.md.copies {
+		if copyFQN == lom.FQN {
+			continue
+		}
+		if err = checkSpace(mi, size); err != nil {
+			rollback()
+			return
+		}
+		var dstCksum *cos.CksumHash
+		workFQN := mi.MakePathFQN(lom.Bucket(), fs.WorkfileType, fs.WorkfileCopy+"."+lom.ObjName)
+		works[copyFQN] = workFQN
+		if dstCksum, err = copyFile(newFQN, workFQN, buf, cksumType); err != nil {
+			rollback()
+			return
+		}
+		if dstCksum != nil {
+			if cksum == nil {
+				cksum = dstCksum.Clone()
+			} else if !cksum.Equal(&dstCksum.Cksum) {
+				err = cos.NewBadDataCksumError(&dstCksum.Cksum, cksum, copyFQN)
+				rollback()
+				return
+			}
 		}
-		return
-	}
-add:
-	// add md and persist
-	lom.AddCopy(copyFQN, mi)
-	err = lom.Persist()
-	if err != nil {
-		lom.delCopyMd(copyFQN)
-		glog.Error(err)
-		return err
-	}
-	err = lom.syncMetaWithCopies()
-	return
-}
-
-// copy object => any local destination
-// recommended for copying between different buckets (compare with lom.Copy() above)
-// NOTE: `lom` source must be w-locked
-func (lom *LOM) Copy2FQN(dstFQN string, buf []byte) (dst *LOM, err error) {
-	dst = lom.CloneMD(dstFQN)
-	if err = dst.InitFQN(dstFQN, nil); err == nil {
-		err = lom.copy2fqn(dst, buf)
 	}
-	if err != nil {
-		FreeLOM(dst)
-		dst = nil
+	if cksum == nil && cksumType != "" && cksumType != cos.ChecksumNone {
+		var cksumHash *cos.CksumHash
+		if cksumHash, err = cos.ChecksumFile(newFQN, cksumType, buf); err != nil {
+			rollback()
+			return
+		}
+		cksum = cksumHash.Clone()
 	}
-	return
-}
 
-func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
-	var (
-		dstCksum  *cos.CksumHash
-		dstFQN    = dst.FQN
-		srcCksum  = lom.Checksum()
-		cksumType = cos.ChecksumNone
-	)
-	if !srcCksum.IsEmpty() {
-		cksumType = srcCksum.Ty()
+	// 2. new metadata => new files
+	if cksum == nil {
+		cksum = cos.NoneCksum
 	}
-	if dst.isMirror(lom) && lom.md.copies != nil {
-		dst.md.copies = make(fs.MPI, len(lom.md.copies)+1)
-		for fqn, mpi := range lom.md.copies {
-			dst.md.copies[fqn] = mpi
+	lom.SetSize(size)
+	lom.SetCksum(cksum)
+	if lom.Bck().IsAIS() && lom.VersionConf().Enabled {
+		if err = lom.IncVersion(); err != nil {
+			rollback()
+			return
 		}
 	}
-	if !dst.Bck().Equal(lom.Bck(), true /*same ID*/, true /*same backend*/) {
-		// The copy will be in a new bucket - completely separate object. Hence, we have to set initial version.
-		dst.SetVersion(lomInitialVersion)
+	mdbuf, mm := lom.marshal()
+	if err = fs.SetXattr(newFQN, XattrLOM, mdbuf); err == nil {
+		for _, workFQN := range works {
+			if err = fs.SetXattr(workFQN, XattrLOM, mdbuf); err != nil {
+				break
+			}
+		}
 	}
-
-	workFQN := fs.CSM.Gen(dst, fs.WorkfileType, fs.WorkfileCopy)
-	_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, cksumType)
+	mm.Free(mdbuf)
 	if err != nil {
+		rollback()
 		return
 	}
 
-	if err = cos.Rename(workFQN, dstFQN); err != nil {
-		if errRemove := cos.RemoveFile(workFQN); errRemove != nil {
-			glog.Errorf(fmtNestedErr, errRemove)
-		}
+	// 3. commit: the object first, and then its copies
+	if err = lom.RenameFile(newFQN); err != nil {
+		rollback()
 		return
 	}
-
-	if cksumType != cos.ChecksumNone {
-		if !dstCksum.Equal(lom.Checksum()) {
-			return cos.NewBadDataCksumError(&dstCksum.Cksum, lom.Checksum())
-		}
-		dst.SetCksum(dstCksum.Clone())
-	}
-
-	// persist
-	if lom.isMirror(dst) {
-		if lom.md.copies == nil {
-			lom.md.copies = make(fs.MPI, 2)
-			dst.md.copies = make(fs.MPI, 2)
+	var dropped bool
+	for copyFQN, workFQN := range works {
+		errCommit := fops.rename(workFQN, copyFQN)
+		if errCommit == nil {
+			continue
 		}
-		lom.md.copies[dstFQN], dst.md.copies[dstFQN] = dst.mpathInfo, dst.mpathInfo
-		lom.md.copies[lom.FQN], dst.md.copies[lom.FQN] = lom.mpathInfo, lom.mpathInfo
-		if err = lom.syncMetaWithCopies(); err != nil {
-			if _, ok := lom.md.copies[dst.FQN]; !ok {
-				if errRemove := os.Remove(dst.FQN); errRemove != nil {
-					glog.Errorf("nested err: %v", errRemove)
-				}
-			}
-			// `lom.syncMetaWithCopies()` may have made changes notwithstanding
-			if errPersist := lom.Persist(); errPersist != nil {
-				glog.Errorf("nested err: %v", errPersist)
+		glog.Errorf("%s: failed to replace copy %q (%v) - dropping it", lom, copyFQN, errCommit)
+		for _, fqn := range []string{workFQN, copyFQN} {
+			if errRemove := cos.RemoveFile(fqn); errRemove != nil {
+				glog.Errorf(fmtNestedErr, errRemove)
 			}
-			return
 		}
-		err = lom.Persist()
-	} else if err = dst.Persist(); err != nil {
-		if errRemove := os.Remove(dst.FQN); errRemove != nil {
-			glog.Errorf("nested err: %v", errRemove)
+		if mpi, ok := lom.md.copies[copyFQN]; ok {
+			fs.DecCopies(mpi.Path)
 		}
+		lom.delCopyMd(copyFQN)
+		dropped = true
 	}
-	return
-}
-
-// load-balanced GET
-func (lom *LOM) LBGet() (fqn string) {
-	if !lom.HasCopies() {
-		return lom.FQN
+	if dropped {
+		lom.persistCopies()
+		return lom.Persist()
 	}
-	return lom.leastUtilCopy()
+	lom.md.clearDirty()
+	lom.Recache()
+	return
 }
 
 // NOTE: reconsider counting GETs (and the associated overhead)
@@ -373,25 +1803,171 @@ func (lom *LOM) leastUtilCopy() (fqn string) {
 	return
 }
 
+// large reads: the score is the sum of the mountpath's utilization, its share (%) of the
+// total write throughput across all copies, and half its used capacity (%) - the lower the better
+func (lom *LOM) leastLoadedCopy() (fqn string) {
+	var (
+		mpathUtils = fs.GetAllMpathUtils()
+		copies     = lom.GetCopies()
+		wbps       = make(map[string]int64, len(copies))
+		total      int64
+		minScore   = int64(math.MaxInt64)
+	)
+	for _, mi := range copies {
+		w := fs.GetMpathWbps(mi.Path)
+		wbps[mi.Path] = w
+		total += w
+	}
+	fqn = lom.FQN
+	score := func(mi *fs.MountpathInfo) (s int64) {
+		s = mpathUtils.Get(mi.Path) + int64(mi.GetCapacity().PctUsed)/2
+		if total > 0 {
+			s += wbps[mi.Path] * 100 / total
+		}
+		return
+	}
+	if mi, ok := copies[lom.FQN]; ok {
+		minScore = score(mi)
+	}
+	for copyFQN, copyMPI := range copies {
+		if copyFQN == lom.FQN {
+			continue
+		}
+		if s := score(copyMPI); s < minScore {
+			fqn, minScore = copyFQN, s
+		}
+	}
+	return
+}
+
 // returns the least utilized mountpath that does _not_ have a copy of this `lom` yet
 // (compare with leastUtilCopy())
+// - equally utilized mountpaths are ordered deterministically, HRW-style (see HrwMpath);
+// - mountpaths below the free capacity watermark (config.Space.MirrorMinFree) are skipped
+// unless all of them are, in which case the one with the most free space is returned;
+// - only the mountpaths allowed by the bucket's mirror config (mirror.mpaths) are considered;
+// - anti-affinity: with failure domains configured (see fs.MountpathInfo.Domain), mountpaths
+// in the domains that already hold a copy are considered only when there are no alternatives -
+// and then placed anyway or refused (feat.StrictCopyAntiAffinity)
 func (lom *LOM) LeastUtilNoCopy() (mi *fs.MountpathInfo) {
 	var (
 		availablePaths = fs.GetAvail()
 		mpathUtils     = fs.GetAllMpathUtils()
+		mirror         = lom.MirrorConf()
 		minUtil        = int64(101) // to motivate the first assignment
+		digest         = xxhash.ChecksumString64S(lom.md.uname, cos.MLCG32)
+		minFree        = cmn.GCO.Get().Space.MirrorMinFree
+		size           = lom.SizeBytes()
+		skipSpace      = cmn.Features.IsSet(feat.SkipCopySpaceCheck)
+		held           = lom.copyDomains("")
+		maxCs          uint64
+		maxAvail       uint64
+		mostFree       *fs.MountpathInfo
+		candidates     fs.MPI // only when observed (see RegPlacementCB)
+		allowed        bool
+		miSame, mfSame bool // (in a domain that already holds a copy)
 	)
+	if placementCB != nil {
+		candidates = make(fs.MPI, len(availablePaths))
+	}
 	for mpath, mpathInfo := range availablePaths {
+		if !mirror.MpathAllowed(mpath) {
+			continue
+		}
+		allowed = true
 		if lom.haveMpath(mpath) || mpathInfo.IsAnySet(fs.FlagWaitingDD) {
 			continue
 		}
-		if util := mpathUtils.Get(mpath); util < minUtil {
-			minUtil, mi = util, mpathInfo
+		c := mpathInfo.GetCapacity()
+		if !skipSpace && !fitsCapacity(c, size) {
+			continue // never choose a mountpath that cannot fit the copy (see checkSpace)
+		}
+		if candidates != nil {
+			candidates[mpath] = mpathInfo
+		}
+		same := held[mpathInfo.Domain]
+		if minFree > 0 {
+			if 100-int64(c.PctUsed) < minFree {
+				if mostFree == nil || (mfSame && !same) || (mfSame == same && c.Avail > maxAvail) {
+					mostFree, maxAvail, mfSame = mpathInfo, c.Avail, same
+				}
+				continue
+			}
+		}
+		if mi != nil && !miSame && same {
+			continue
+		}
+		util := mpathUtils.Get(mpath)
+		if mi != nil && miSame == same && util > minUtil {
+			continue
+		}
+		cs := xoshiro256.Hash(mpathInfo.PathDigest ^ digest)
+		if mi == nil || miSame != same || util < minUtil || cs > maxCs {
+			minUtil, maxCs, mi, miSame = util, cs, mpathInfo, same
+		}
+	}
+	if !allowed {
+		glog.Warningf("%s: none of the available mountpaths is allowed to store copies (mirror.mpaths %v)",
+			lom, mirror.Mpaths)
+		lom.placed(nil, candidates)
+		return
+	}
+	if mi != nil && miSame && mostFree != nil && !mfSame {
+		mi = nil // anti-affinity takes precedence over the watermark
+	}
+	if mi == nil && mostFree != nil {
+		glog.Warningf("%s: all mountpaths are below the free capacity watermark (%d%%), falling back to %s",
+			lom, minFree, mostFree)
+		mi, miSame = mostFree, mfSame
+	}
+	if mi != nil && miSame {
+		if cmn.Features.IsSet(feat.StrictCopyAntiAffinity) {
+			glog.Warningf("%s: refusing to place another copy in the failure domain %q (%s)", lom, mi.Domain, mi)
+			mi = nil
+		} else {
+			glog.Warningf("%s: failure domain %q already holds a copy - placing anyway (%s)", lom, mi.Domain, mi)
+		}
+	}
+	lom.placed(mi, candidates)
+	return
+}
+
+// failure domains (see fs.MountpathInfo.Domain) that hold this `lom` or its copies
+// other than `except` (mountpath); nil when none are labeled
+func (lom *LOM) copyDomains(except string) (held map[string]bool) {
+	add := func(mi *fs.MountpathInfo) {
+		if mi.Domain == "" || mi.Path == except {
+			return
+		}
+		if held == nil {
+			held = make(map[string]bool, 2)
 		}
+		held[mi.Domain] = true
+	}
+	if len(lom.md.copies) == 0 {
+		add(lom.mpathInfo)
+		return
+	}
+	for _, mi := range lom.md.copies {
+		add(mi)
 	}
 	return
 }
 
+// RegPlacementCB registers (or, when nil, unregisters) a callback to observe - not override -
+// the mountpaths chosen for this target's objects and their copies (see ToMpath and LeastUtilNoCopy);
+// `chosen` is nil when no mountpath is eligible. Intended for telemetry; must be called
+// prior to any placement (e.g., at startup) and must not be called concurrently with it.
+// NOTE: the callback runs inline, under the object's lock - it must be fast and it must not
+// attempt to lock the object.
+func RegPlacementCB(cb PlacementCB) { placementCB = cb }
+
+func (lom *LOM) placed(chosen *fs.MountpathInfo, candidates fs.MPI) {
+	if placementCB != nil {
+		placementCB(lom, chosen, candidates)
+	}
+}
+
 func (lom *LOM) haveMpath(mpath string) bool {
 	if len(lom.md.copies) == 0 {
 		return lom.mpathInfo.Path == mpath
@@ -409,6 +1985,9 @@ func (lom *LOM) haveMpath(mpath string) bool {
 // - checks hrw location first, and
 // - checks copies (if any) against the current configuation and available mountpaths;
 // - does not check `fstat` in either case (TODO: configurable or scrub);
+// - with `mirror.age_limit` configured, expects the number of copies that depends on the object's age;
+// - never drops pinned copies (see PinCopy) - the ones on unavailable mountpaths are just not counted;
+// - new copies are placed on the allowed mountpaths only (mirror.mpaths, see LeastUtilNoCopy)
 func (lom *LOM) ToMpath() (mi *fs.MountpathInfo, isHrw bool) {
 	var (
 		availablePaths = fs.GetAvail()
@@ -420,18 +1999,27 @@ func (lom *LOM) ToMpath() (mi *fs.MountpathInfo, isHrw bool) {
 	}
 	debug.Assert(!hrwMi.IsAnySet(fs.FlagWaitingDD))
 	if lom.mpathInfo.Path != hrwMi.Path {
+		lom.placed(hrwMi, availablePaths)
 		return hrwMi, true
 	}
-	mirror := lom.MirrorConf()
-	if !mirror.Enabled || mirror.Copies < 2 {
+	if !lom.MirrorConf().Enabled {
 		return
 	}
-	// count copies vs. configuration
+	// count copies vs. configuration (or per-object override, or age-based rule)
 	// take into account mountpath flags but stop short of `fstat`-ing
-	expCopies, gotCopies := int(mirror.Copies), 0
+	expCopies, gotCopies := int(lom.MirrorCopies()), 0
+	if expCopies < 2 {
+		return
+	}
 	for fqn, mpi := range lom.md.copies {
 		mpathInfo, ok := availablePaths[mpi.Path]
 		if !ok || mpathInfo.IsAnySet(fs.FlagWaitingDD) {
+			if lom.IsPinned(fqn) {
+				continue // keep it but don't count it
+			}
+			if fqn != lom.FQN {
+				fs.DecCopies(mpi.Path)
+			}
 			lom.delCopyMd(fqn)
 		} else {
 			gotCopies++
@@ -447,3 +2035,96 @@ func (lom *LOM) ToMpath() (mi *fs.MountpathInfo, isHrw bool) {
 	}
 	return
 }
+
+// AuditMpathCopies reconciles, in a single walk of the given mountpath, object files against
+// copies metadata (compare with DelExtraCopies that does it for a single object). Returns
+// orphans - object files that are not at their default (HRW) location and are not claimed
+// as copies by their objects' metadata (or else, the object itself is missing); and
+// dangling - copies that are listed in the metadata of the objects residing (at their
+// default locations) on this mountpath but are missing on disk.
+// The audit is read-only (objects are read-locked one at a time) and can be canceled
+// via `ctx`, in which case the results reflect the portion walked so far.
+func AuditMpathCopies(ctx context.Context, mpath string) (orphans, dangling []string, err error) {
+	mi, ok := fs.GetAvail()[mpath]
+	if !ok {
+		return nil, nil, cmn.NewErrMountpathNotFound(mpath, "" /*fqn*/, false /*disabled*/)
+	}
+	T.Bowner().Get().Range(nil, nil, func(bck *Bck) bool {
+		if err = ctx.Err(); err != nil {
+			return true
+		}
+		cb := func(fqn string, de fs.DirEntry) error {
+			if de.IsDir() {
+				return nil
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			orphan, missing := auditCopies(fqn, bck)
+			if orphan {
+				orphans = append(orphans, fqn)
+			}
+			dangling = append(dangling, missing...)
+			return nil
+		}
+		opts := &fs.WalkOpts{Mi: mi, Bck: *bck.Bucket(), CTs: []string{fs.ObjectType}, Callback: cb}
+		err = fs.Walk(opts)
+		return err != nil // stop
+	})
+	sort.Strings(orphans)
+	sort.Strings(dangling)
+	return
+}
+
+func auditCopies(fqn string, bck *Bck) (orphan bool, missing []string) {
+	lom := AllocLOM("")
+	defer FreeLOM(lom)
+	if err := lom.InitFQN(fqn, bck.Bucket()); err != nil {
+		return
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if lom.IsHRW() {
+		if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+			return // (removed in the meantime or no metadata - not a copies-related condition)
+		}
+		for copyFQN := range lom.md.copies {
+			if copyFQN == lom.FQN {
+				continue
+			}
+			if err := cos.Stat(copyFQN); os.IsNotExist(err) {
+				missing = append(missing, copyFQN)
+			}
+		}
+		return
+	}
+	if err := cos.Stat(fqn); os.IsNotExist(err) {
+		return // removed in the meantime
+	}
+	// not at its default location: must be claimed by the object
+	hlom := AllocLOM(lom.ObjName)
+	defer FreeLOM(hlom)
+	if err := hlom.InitBck(lom.Bucket()); err != nil {
+		return
+	}
+	if err := hlom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return true, nil
+	}
+	_, claimed := hlom.md.copies[fqn]
+	return !claimed, nil
+}
+
+// StaleCopyWorkfiles enumerates (without removing) workfiles left behind by interrupted
+// copies (see Copy, Copy2FQN) in all buckets and across all available mountpaths,
+// returning those that have not been modified for at least `olderThan`
+func StaleCopyWorkfiles(olderThan time.Duration) (stale []fs.StaleWorkfile, err error) {
+	T.Bowner().Get().Range(nil, nil, func(bck *Bck) bool {
+		var found []fs.StaleWorkfile
+		if found, err = fs.StaleWorkfiles(bck.Bucket(), fs.WorkfileCopy, olderThan); err != nil {
+			return true // stop
+		}
+		stale = append(stale, found...)
+		return false
+	})
+	return
+}
@@ -0,0 +1,61 @@
+// Package cluster provides common interfaces and local access to cluster-level metadata
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cluster
+
+import (
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// file operations that fail when told so (see fileOps)
+type faultyOps struct {
+	osFileOps
+	copyFault   func(src, dst string) error
+	renameFault func(src, dst string) error
+	removeFault func(fqn string) error
+}
+
+func (f *faultyOps) copyFile(src, dst string, buf []byte, cksumType string) (int64, *cos.CksumHash, error) {
+	if f.copyFault != nil {
+		if err := f.copyFault(src, dst); err != nil {
+			return 0, nil, err
+		}
+	}
+	return f.osFileOps.copyFile(src, dst, buf, cksumType)
+}
+
+func (f *faultyOps) rename(src, dst string) error {
+	if f.renameFault != nil {
+		if err := f.renameFault(src, dst); err != nil {
+			return err
+		}
+	}
+	return f.osFileOps.rename(src, dst)
+}
+
+func (f *faultyOps) remove(fqn string) error {
+	if f.removeFault != nil {
+		if err := f.removeFault(fqn); err != nil {
+			return err
+		}
+	}
+	return f.osFileOps.remove(fqn)
+}
+
+var faults = &faultyOps{}
+
+func init() { fops = faults }
+
+// (used by cluster_test to inject failures when copying - e.g., restoring from copies)
+func SetCopyFault(f func(src, dst string) error) { faults.copyFault = f }
+
+// (used by cluster_test to inject failures when committing (renaming) replaced copies)
+func SetRenameFault(f func(src, dst string) error) { faults.renameFault = f }
+
+// (used by cluster_test to inject failures when removing copies - see QueueOrphan)
+func SetRemoveFault(f func(fqn string) error) { faults.removeFault = f }
+
+// (used by cluster_test to construct inconsistent metadata - see VerifyMeta)
+func SetCopiesMD(lom *LOM, copies fs.MPI) { lom.md.copies = copies }
@@ -6,7 +6,6 @@ package cluster
 
 import (
 	"fmt"
-	"io"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -20,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/transport"
@@ -43,6 +43,8 @@ type (
 		cmn.ObjAttrs
 		atimefs uint64 // NOTE: high bit is reserved for `dirty`
 		bckID   uint64
+		ncopies int16      // when non-zero, overrides bucket's mirror.copies (see SetMirrorCopies)
+		pinned  cos.StrSet // immovable copies (see PinCopy)
 	}
 	LOM struct {
 		bck         Bck
@@ -76,6 +78,7 @@ func Init(t TargetLoc) {
 	}
 	initLomLocker()
 	T = t
+	SetMaxCopying(cmn.GCO.Get())
 }
 
 func initLomLocker() {
@@ -332,20 +335,10 @@ func (lom *LOM) ComputeSetCksum() (*cos.Cksum, error) {
 }
 
 func (lom *LOM) ComputeCksum(cksumType string) (cksum *cos.CksumHash, err error) {
-	var file *os.File
-	if cksumType == cos.ChecksumNone {
+	if cksumType == cos.ChecksumNone || cksumType == "" {
 		return
 	}
-	if file, err = os.Open(lom.FQN); err != nil {
-		return
-	}
-	// No need to allocate `buf` as `io.Discard` has efficient `io.ReaderFrom` implementation.
-	_, cksum, err = cos.CopyAndChecksum(io.Discard, file, nil, cksumType)
-	cos.Close(file)
-	if err != nil {
-		return nil, err
-	}
-	return
+	return cos.ChecksumFile(lom.FQN, cksumType, nil)
 }
 
 //   - locked: is locked by the immediate caller (or otherwise is known to be locked);
@@ -472,6 +465,9 @@ func (lom *LOM) FromFS() error {
 	}
 	lom.md.Atime = atimefs
 	lom.md.atimefs = uint64(atimefs)
+	if len(lom.md.copies) > 0 && cmn.Features.IsSet(feat.VerifyCopiesOnLoad) {
+		lom.verifyCopies()
+	}
 	return nil
 }
 
@@ -0,0 +1,357 @@
+// Package cluster_test provides tests for cluster package
+/*
+ * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cluster_test
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/atomic"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Large (sequential) mirrored reads that compete with writes on one of the mountpaths:
+// "util" selects the replica by utilization only (as in LBGet), while "load" accounts
+// for write load and free space as well (LBGetFor)
+//
+// go test -bench=LBGet -benchtime=10s -run=^$
+func BenchmarkLBGet(b *testing.B) {
+	const (
+		tmpDir    = "/tmp/lbget_bench"
+		numMpaths = 3
+		objSize   = 16 * cos.MiB
+		objName   = "bench/large-obj"
+	)
+	bck := cmn.Bck{Name: "LBGET_BENCH", Provider: apc.AIS, Ns: cmn.NsGlobal}
+	config := cmn.GCO.BeginUpdate()
+	config.TestFSP.Count = 1
+	config.Disk.DiskUtilLowWM, config.Disk.DiskUtilHighWM = 20, 80
+	cmn.GCO.CommitUpdate(config)
+
+	fs.TestNew(nil)
+	fs.TestDisableValidation()
+	for i := 0; i < numMpaths; i++ {
+		mpath := fmt.Sprintf("%s/mpath%d", tmpDir, i)
+		if err := cos.CreateDir(mpath); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := fs.Add(mpath, "daeID"); err != nil {
+			b.Fatal(err)
+		}
+	}
+	defer os.RemoveAll(tmpDir)
+	_ = fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
+	_ = fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+
+	bmd := mock.NewBaseBownerMock(cluster.NewBck(bck.Name, bck.Provider, bck.Ns, &cmn.BucketProps{
+		Cksum:  cmn.CksumConf{Type: cos.ChecksumNone},
+		Mirror: cmn.MirrorConf{Enabled: true, Copies: numMpaths},
+		BID:    1,
+	}))
+	_ = mock.NewTarget(bmd)
+
+	// object and its copies
+	lom := &cluster.LOM{ObjName: objName}
+	if err := lom.InitBck(&bck); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, cos.MiB)
+	_, _ = rand.Read(buf)
+	writeFile(b, lom.FQN, buf, objSize)
+	lom.SetSize(objSize)
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	if err := lom.Persist(); err != nil {
+		b.Fatal(err)
+	}
+	if _, err := lom.EnsureCopies(buf); err != nil {
+		b.Fatal(err)
+	}
+
+	// concurrent writes to the object's (HRW) mountpath
+	var stop atomic.Bool
+	done := make(chan struct{})
+	go func() {
+		wfqn := filepath.Join(lom.MpathInfo().Path, "bench-writes")
+		for !stop.Load() {
+			writeFile(b, wfqn, buf, 64*cos.MiB)
+		}
+		os.Remove(wfqn)
+		close(done)
+	}()
+	defer func() {
+		stop.Store(true)
+		<-done
+	}()
+
+	for _, test := range []struct {
+		name     string
+		sizeHint int64
+	}{
+		{"util", 0},
+		{"load", objSize},
+	} {
+		b.Run(test.name, func(b *testing.B) {
+			b.SetBytes(objSize)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				rbuf := make([]byte, cos.MiB)
+				for pb.Next() {
+					readLBGet(b, &bck, objName, test.sizeHint, rbuf)
+				}
+			})
+		})
+	}
+}
+
+// Re-running mirror reconciliation over an already mirrored bucket: existing copies are
+// identical and must be kept. "mtime" copies qualify for the (size, mtime) fast path, while
+// "coarse" ones (whole-second mtime, as on a coarse-grained filesystem) are ambiguous and
+// always get escalated to loading the copy's metadata and comparing - which, with no checksums
+// and no versions to compare, ends up copying anew.
+//
+// go test -bench=CopyMirrored -run=^$
+func BenchmarkCopyMirrored(b *testing.B) {
+	const (
+		tmpDir  = "/tmp/copy_mirrored_bench"
+		numObjs = 256
+		objSize = 64 * cos.KiB
+	)
+	bck := cmn.Bck{Name: "COPY_MIRRORED_BENCH", Provider: apc.AIS, Ns: cmn.NsGlobal}
+	config := cmn.GCO.BeginUpdate()
+	config.TestFSP.Count = 1
+	cmn.GCO.CommitUpdate(config)
+
+	fs.TestNew(nil)
+	fs.TestDisableValidation()
+	for i := 0; i < 2; i++ {
+		mpath := fmt.Sprintf("%s/mpath%d", tmpDir, i)
+		if err := cos.CreateDir(mpath); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := fs.Add(mpath, "daeID"); err != nil {
+			b.Fatal(err)
+		}
+	}
+	defer os.RemoveAll(tmpDir)
+	_ = fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
+	_ = fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+
+	bmd := mock.NewBaseBownerMock(cluster.NewBck(bck.Name, bck.Provider, bck.Ns, &cmn.BucketProps{
+		Cksum:  cmn.CksumConf{Type: cos.ChecksumNone},
+		Mirror: cmn.MirrorConf{Enabled: true, Copies: 2},
+		BID:    1,
+	}))
+	_ = mock.NewTarget(bmd)
+
+	buf := make([]byte, objSize)
+	_, _ = rand.Read(buf)
+	for _, test := range []struct {
+		name   string
+		coarse bool
+	}{
+		{"mtime", false},
+		{"coarse", true},
+	} {
+		// already mirrored objects, last modified an hour ago
+		mtime := time.Now().Add(-time.Hour)
+		if test.coarse {
+			mtime = mtime.Truncate(time.Second)
+		}
+		objNames := make([]string, numObjs)
+		for i := range objNames {
+			objNames[i] = fmt.Sprintf("%s/obj-%d", test.name, i)
+			lom := &cluster.LOM{ObjName: objNames[i]}
+			if err := lom.InitBck(&bck); err != nil {
+				b.Fatal(err)
+			}
+			writeFile(b, lom.FQN, buf, objSize)
+			if err := os.Chtimes(lom.FQN, mtime, mtime); err != nil {
+				b.Fatal(err)
+			}
+			lom.SetSize(objSize)
+			lom.SetAtimeUnix(time.Now().UnixNano())
+			if err := lom.Persist(); err != nil {
+				b.Fatal(err)
+			}
+			copyMirrored(b, &bck, objNames[i], buf)
+		}
+
+		b.Run(test.name, func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				copyMirrored(b, &bck, objNames[i%numObjs], buf)
+			}
+		})
+	}
+}
+
+// Large cross-bucket copies between buckets that share the checksum type: "verify" recomputes
+// the checksum while copying and compares it with the source's, while "trust" copies bytes and
+// carries over the source's stored checksum (see feat.TrustCopyChecksum)
+//
+// go test -bench=Copy2FQN -run=^$
+func BenchmarkCopy2FQN(b *testing.B) {
+	const (
+		tmpDir  = "/tmp/copy2fqn_bench"
+		objSize = 64 * cos.MiB
+		objName = "bench/large-obj"
+	)
+	var (
+		srcBck = cmn.Bck{Name: "COPY2FQN_BENCH_SRC", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		dstBck = cmn.Bck{Name: "COPY2FQN_BENCH_DST", Provider: apc.AIS, Ns: cmn.NsGlobal}
+	)
+	config := cmn.GCO.BeginUpdate()
+	config.TestFSP.Count = 1
+	cmn.GCO.CommitUpdate(config)
+
+	fs.TestNew(nil)
+	fs.TestDisableValidation()
+	mpath := tmpDir + "/mpath"
+	if err := cos.CreateDir(mpath); err != nil {
+		b.Fatal(err)
+	}
+	if _, err := fs.Add(mpath, "daeID"); err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	_ = fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
+	_ = fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+
+	bmd := mock.NewBaseBownerMock(
+		cluster.NewBck(srcBck.Name, srcBck.Provider, srcBck.Ns, &cmn.BucketProps{
+			Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash},
+			BID:   1,
+		}),
+		cluster.NewBck(dstBck.Name, dstBck.Provider, dstBck.Ns, &cmn.BucketProps{
+			Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash},
+			BID:   2,
+		}),
+	)
+	_ = mock.NewTarget(bmd)
+
+	// source object with its checksum
+	lom := &cluster.LOM{ObjName: objName}
+	if err := lom.InitBck(&srcBck); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, cos.MiB)
+	_, _ = rand.Read(buf)
+	writeFile(b, lom.FQN, buf, objSize)
+	lom.SetSize(objSize)
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	if err := lom.Persist(); err != nil {
+		b.Fatal(err)
+	}
+	if err := lom.ValidateContentChecksum(); err != nil {
+		b.Fatal(err)
+	}
+	dst := &cluster.LOM{ObjName: objName}
+	if err := dst.InitBck(&dstBck); err != nil {
+		b.Fatal(err)
+	}
+
+	features := cmn.Features
+	defer func() { cmn.Features = features }()
+	for _, test := range []struct {
+		name  string
+		trust bool
+	}{
+		{"verify", false},
+		{"trust", true},
+	} {
+		cmn.Features = features
+		if test.trust {
+			cmn.Features = cmn.Features.Set(feat.TrustCopyChecksum)
+		}
+		b.Run(test.name, func(b *testing.B) {
+			b.SetBytes(objSize)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				lom.Lock(true)
+				cpy, err := lom.Copy2FQN(dst.FQN, buf)
+				lom.Unlock(true)
+				if err != nil {
+					b.Fatal(err)
+				}
+				cluster.FreeLOM(cpy)
+			}
+		})
+	}
+}
+
+// (re)copy the object to the mountpath other than its own
+func copyMirrored(b *testing.B, bck *cmn.Bck, objName string, buf []byte) {
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		b.Fatal(err)
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		b.Fatal(err)
+	}
+	for _, mi := range fs.GetAvail() {
+		if mi.Path == lom.MpathInfo().Path {
+			continue
+		}
+		if err := lom.Copy(mi, buf); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func readLBGet(b *testing.B, bck *cmn.Bck, objName string, sizeHint int64, buf []byte) {
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		b.Fatal(err)
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		b.Fatal(err)
+	}
+	fh, err := os.Open(lom.LBGetFor(sizeHint))
+	if err != nil {
+		b.Fatal(err)
+	}
+	_, err = io.CopyBuffer(io.Discard, fh, buf)
+	fh.Close()
+	if err != nil {
+		b.Fatal(err)
+	}
+}
+
+func writeFile(b *testing.B, fqn string, buf []byte, size int) {
+	fh, err := cos.CreateFile(fqn)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+	for written := 0; written < size; written += len(buf) {
+		if _, err = fh.Write(buf); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = fh.Sync()
+	}
+	fh.Close()
+	if err != nil {
+		b.Error(err)
+	}
+}
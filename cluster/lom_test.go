@@ -5,12 +5,20 @@
 package cluster_test
 
 import (
+	"bytes"
+	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
+	"syscall"
+	"testing/iotest"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -18,6 +26,7 @@ import (
 	"github.com/NVIDIA/aistore/cluster/mock"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/fs"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -32,6 +41,11 @@ var _ = Describe("LOM", func() {
 		bucketLocalA = "LOM_TEST_Local_A"
 		bucketLocalB = "LOM_TEST_Local_B"
 		bucketLocalC = "LOM_TEST_Local_C"
+		bucketLocalD = "LOM_TEST_Local_D"
+		bucketLocalE = "LOM_TEST_Local_E"
+		bucketLocalF = "LOM_TEST_Local_F"
+		bucketLocalG = "LOM_TEST_Local_G"
+		bucketLocalH = "LOM_TEST_Local_H"
 
 		bucketCloudA = "LOM_TEST_Cloud_A"
 		bucketCloudB = "LOM_TEST_Cloud_B"
@@ -61,6 +75,7 @@ var _ = Describe("LOM", func() {
 
 	config := cmn.GCO.BeginUpdate()
 	config.TestFSP.Count = 1
+	config.Disk.DiskUtilLowWM, config.Disk.DiskUtilHighWM = 20, 80 // (mountpath utilization, see LeastUtilNoCopy)
 	cmn.GCO.CommitUpdate(config)
 
 	fs.TestNew(nil)
@@ -90,6 +105,42 @@ var _ = Describe("LOM", func() {
 				BID:    3,
 			},
 		),
+		cluster.NewBck(
+			bucketLocalD, apc.AIS, cmn.NsGlobal,
+			&cmn.BucketProps{
+				Cksum:  cmn.CksumConf{Type: cos.ChecksumXXHash},
+				Mirror: cmn.MirrorConf{Enabled: true, Copies: 2, HealOnGet: true},
+				BID:    8,
+			},
+		),
+		cluster.NewBck(
+			bucketLocalE, apc.AIS, cmn.NsGlobal,
+			&cmn.BucketProps{
+				Cksum:  cmn.CksumConf{Type: cos.ChecksumXXHash},
+				Mirror: cmn.MirrorConf{Enabled: true, Copies: numMpaths + 1},
+				BID:    9,
+			},
+		),
+		cluster.NewBck(
+			bucketLocalF, apc.AIS, cmn.NsGlobal,
+			&cmn.BucketProps{Cksum: cmn.CksumConf{Type: cos.ChecksumSHA256}, BID: 10},
+		),
+		cluster.NewBck(
+			bucketLocalG, apc.AIS, cmn.NsGlobal,
+			&cmn.BucketProps{
+				Cksum:  cmn.CksumConf{Type: cos.ChecksumXXHash},
+				Mirror: cmn.MirrorConf{Enabled: true, Copies: 2, LazyCksum: true},
+				BID:    11,
+			},
+		),
+		cluster.NewBck(
+			bucketLocalH, apc.AIS, cmn.NsGlobal,
+			&cmn.BucketProps{
+				Cksum:  cmn.CksumConf{Type: cos.ChecksumXXHash},
+				Mirror: cmn.MirrorConf{Enabled: true, Copies: numMpaths, AgeLimit: cos.Duration(time.Hour), ColdCopies: 1},
+				BID:    12,
+			},
+		),
 		cluster.NewBck(sameBucketName, apc.AIS, cmn.NsGlobal, &cmn.BucketProps{BID: 4}),
 		cluster.NewBck(bucketCloudA, apc.AWS, cmn.NsGlobal, &cmn.BucketProps{BID: 5}),
 		cluster.NewBck(bucketCloudB, apc.AWS, cmn.NsGlobal, &cmn.BucketProps{BID: 6}),
@@ -893,72 +944,2161 @@ var _ = Describe("LOM", func() {
 			})
 		})
 
-		Describe("DelCopies", func() {
-			It("should delete mirrored copy", func() {
+		Describe("Copy2FQN (cross-bucket checksum)", func() {
+			var (
+				noCksumFQN = findMpath(testObjectName, bucketLocalA, true /*defaultLoc*/)
+				sha256FQN  = findMpath(testObjectName, bucketLocalF, true /*defaultLoc*/)
+			)
+			copy2fqn := func(lom *cluster.LOM, fqn string) *cluster.LOM {
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				dst, err := lom.Copy2FQN(fqn, make([]byte, testFileSize))
+				Expect(err).NotTo(HaveOccurred())
+				dst = NewBasicLom(dst.FQN)
+				Expect(dst.Load(false, false)).NotTo(HaveOccurred())
+				return dst
+			}
+
+			It("should compute destination bucket's checksum when the source has none", func() {
+				lom := prepareLOM(noCksumFQN)
+				Expect(lom.Checksum().IsEmpty()).To(BeTrue())
+
+				dst := copy2fqn(lom, copyFQNs[0])
+				Expect(dst.Checksum().Ty()).To(Equal(cos.ChecksumXXHash))
+				_, cksumValue := dst.Checksum().Get()
+				Expect(cksumValue).To(Equal(getTestFileHash(lom.FQN)))
+				Expect(dst.ValidateContentChecksum()).NotTo(HaveOccurred())
+			})
+
+			It("should keep (and validate) source checksum of a different type", func() {
+				lom := prepareLOM(copyFQNs[0])
+				Expect(lom.Checksum().Ty()).To(Equal(cos.ChecksumXXHash))
+
+				dst := copy2fqn(lom, sha256FQN)
+				Expect(dst.Checksum().Equal(lom.Checksum())).To(BeTrue())
+				Expect(getTestFileHash(dst.FQN)).To(Equal(getTestFileHash(lom.FQN)))
+			})
+
+			It("should verify same-type checksum unless configured to trust it", func() {
+				var (
+					features = cmn.Features
+					dstFQN   = findMpath(testObjectName, bucketLocalD, true /*defaultLoc*/)
+				)
+				defer func() { cmn.Features = features }()
+				lom := prepareLOM(copyFQNs[0])
+				cksum := lom.Checksum().Clone()
+
+				// corrupt the source behind its stored checksum
+				createTestFile(lom.FQN, testFileSize)
+
+				// default: recompute and verify
+				lom.Lock(true)
+				_, err := lom.Copy2FQN(dstFQN, make([]byte, testFileSize))
+				lom.Unlock(true)
+				Expect(cos.IsErrBadCksum(err)).To(BeTrue())
+
+				// trust: carry over the stored checksum as is
+				cmn.Features = cmn.Features.Set(feat.TrustCopyChecksum)
+				dst := copy2fqn(lom, dstFQN)
+				Expect(dst.Checksum().Equal(cksum)).To(BeTrue())
+				Expect(dst.ValidateContentChecksum()).To(HaveOccurred())
+			})
+		})
+
+		Describe("Copy2FQNMode", func() {
+			var (
+				srcFQN = findMpath(testObjectName, bucketLocalA, true /*defaultLoc*/)
+				dstFQN = findMpath(testObjectName, bucketLocalD, true /*defaultLoc*/)
+			)
+			copy2fqn := func(mode cluster.Collision) (*cluster.LOM, error) {
+				lom := prepareLOM(srcFQN)
+				existing := filePut(dstFQN, testFileSize/2)
+				Expect(existing.Load(false, false)).NotTo(HaveOccurred())
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				return lom.Copy2FQNMode(dstFQN, make([]byte, testFileSize), mode)
+			}
+			expectPreserved := func() {
+				dst := NewBasicLom(dstFQN)
+				Expect(dst.Load(false, false)).NotTo(HaveOccurred())
+				Expect(dst.SizeBytes()).To(BeEquivalentTo(testFileSize / 2))
+				Expect(getTestFileHash(dstFQN)).NotTo(Equal(getTestFileHash(srcFQN)))
+			}
+
+			It("should overwrite an existing destination", func() {
+				dst, err := copy2fqn(cluster.CollOverwrite)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dst.FQN).To(Equal(dstFQN))
+				Expect(getTestFileHash(dstFQN)).To(Equal(getTestFileHash(srcFQN)))
+			})
+
+			It("should skip and preserve an existing destination", func() {
+				dst, err := copy2fqn(cluster.CollSkip)
+				Expect(err).To(Equal(cmn.ErrSkip))
+				Expect(dst).To(BeNil())
+				expectPreserved()
+			})
+
+			It("should fail and preserve an existing destination", func() {
+				dst, err := copy2fqn(cluster.CollFail)
+				Expect(cmn.IsErrObjExists(err)).To(BeTrue())
+				Expect(dst).To(BeNil())
+				expectPreserved()
+			})
+
+			It("should copy when there's no destination regardless of the mode", func() {
+				for _, mode := range []cluster.Collision{cluster.CollSkip, cluster.CollFail} {
+					lom := prepareLOM(srcFQN)
+					Expect(cos.RemoveFile(dstFQN)).NotTo(HaveOccurred())
+					lom.Lock(true)
+					dst, err := lom.Copy2FQNMode(dstFQN, make([]byte, testFileSize), mode)
+					lom.Unlock(true)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(getTestFileHash(dst.FQN)).To(Equal(getTestFileHash(srcFQN)))
+				}
+			})
+		})
+
+		Describe("WriteToFQN", func() {
+			var (
+				noCksumFQN = findMpath(testObjectName, bucketLocalA, true /*defaultLoc*/)
+				sha256FQN  = findMpath(testObjectName, bucketLocalF, true /*defaultLoc*/)
+			)
+			write2fqn := func(lom *cluster.LOM, fqn string, r io.Reader, cksumType string) (*cluster.LOM, error) {
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				return lom.WriteToFQN(fqn, r, cksumType, make([]byte, testFileSize))
+			}
+			derived := func() []byte {
+				b := make([]byte, testFileSize*2)
+				_, _ = rand.Read(b)
+				return b
+			}
+
+			It("should write reader's content and compute destination bucket's checksum", func() {
+				lom := prepareLOM(noCksumFQN)
+				data := derived()
+
+				dst, err := write2fqn(lom, sha256FQN, bytes.NewReader(data), "")
+				Expect(err).NotTo(HaveOccurred())
+				dst = NewBasicLom(dst.FQN)
+				Expect(dst.Load(false, false)).NotTo(HaveOccurred())
+				Expect(dst.SizeBytes()).To(BeEquivalentTo(len(data)))
+				Expect(dst.Checksum().Ty()).To(Equal(cos.ChecksumSHA256))
+				Expect(dst.ValidateContentChecksum()).NotTo(HaveOccurred())
+				b, err := os.ReadFile(dst.FQN)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(b).To(Equal(data))
+			})
+
+			It("should fail on checksum mismatch and leave no destination", func() {
+				lom := prepareLOM(copyFQNs[0])
+				Expect(lom.Checksum().Ty()).To(Equal(cos.ChecksumXXHash))
+				_ = os.Remove(sha256FQN)
+
+				dst, err := write2fqn(lom, sha256FQN, bytes.NewReader(derived()), cos.ChecksumXXHash)
+				Expect(cos.IsErrBadCksum(err)).To(BeTrue())
+				Expect(dst).To(BeNil())
+				Expect(sha256FQN).NotTo(BeAnExistingFile())
+			})
+
+			It("should fail on reader error and leave no destination", func() {
+				lom := prepareLOM(noCksumFQN)
+				_ = os.Remove(sha256FQN)
+				errRead := errors.New("reader failed mid-stream")
+				r := io.MultiReader(bytes.NewReader(derived()), iotest.ErrReader(errRead))
+
+				dst, err := write2fqn(lom, sha256FQN, r, "")
+				Expect(err).To(MatchError(errRead))
+				Expect(dst).To(BeNil())
+				Expect(sha256FQN).NotTo(BeAnExistingFile())
+			})
+		})
+
+		Describe("LBGet", func() {
+			It("should heal under-replicated object on GET", func() {
+				lom := prepareLOM(findMpath(testObjectName, bucketLocalD, true /*defaultLoc*/))
+				Expect(lom.NumCopies()).To(Equal(1))
+
+				lom.Lock(false)
+				Expect(lom.LBGet()).To(Equal(lom.FQN))
+				lom.Unlock(false)
+
+				Eventually(func() int {
+					lom := NewBasicLom(lom.FQN)
+					lom.Lock(false)
+					defer lom.Unlock(false)
+					if err := lom.Load(false, true); err != nil {
+						return 0
+					}
+					return lom.NumCopies()
+				}, 5*time.Second, 10*time.Millisecond).Should(Equal(2))
+			})
+
+			It("should steer large reads away from a nearly full mountpath", func() {
 				lom := prepareLOM(mirrorFQNs[0])
 				_ = prepareCopy(lom, mirrorFQNs[1])
+				lom = NewBasicLom(lom.FQN)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(2))
+
+				availablePaths := fs.GetAvail()
+				defer func() {
+					for _, mi := range availablePaths {
+						mi.TestSetCapacity(fs.Capacity{})
+					}
+				}()
+				availablePaths[lom.MpathInfo().Path].TestSetCapacity(fs.Capacity{Used: 95 * cos.GiB, Avail: 5 * cos.GiB, PctUsed: 95})
 
-				// Check that no copies were added to metadata.
 				lom.Lock(false)
 				defer lom.Unlock(false)
-				Expect(lom.IsCopy()).To(BeFalse())
-				Expect(lom.HasCopies()).To(BeTrue())
-				Expect(lom.NumCopies()).To(Equal(2))
-				Expect(lom.GetCopies()).To(And(HaveKey(mirrorFQNs[0]), HaveKey(mirrorFQNs[1])))
+				Expect(lom.LBGetFor(testFileSize)).To(Equal(lom.FQN)) // small: utilization only
+				Expect(lom.LBGetFor(64 * cos.MiB)).To(Equal(mirrorFQNs[1]))
+			})
 
-				// Delete copy and check if it's gone.
-				Expect(lom.DelCopies(mirrorFQNs[1])).ToNot(HaveOccurred())
-				Expect(persist(lom)).ToNot(HaveOccurred())
-				Expect(mirrorFQNs[1]).NotTo(BeAnExistingFile())
+			It("should always read the default location when load balancing is disabled", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				lom = NewBasicLom(lom.FQN)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(3))
+
+				availablePaths := fs.GetAvail()
+				mirror := lom.MirrorConf()
+				defer func() {
+					mirror.DisableLBGet = false
+					for _, mi := range availablePaths {
+						mi.TestSetCapacity(fs.Capacity{})
+					}
+				}()
+				availablePaths[lom.MpathInfo().Path].TestSetCapacity(fs.Capacity{Used: 95 * cos.GiB, Avail: 5 * cos.GiB, PctUsed: 95})
+
+				lom.Lock(false)
+				defer lom.Unlock(false)
+				Expect(lom.LBGetFor(64 * cos.MiB)).NotTo(Equal(lom.FQN))
+
+				mirror.DisableLBGet = true
+				for i := 0; i < 10; i++ {
+					Expect(lom.LBGet()).To(Equal(mirrorFQNs[0]))
+					Expect(lom.LBGetFor(64 * cos.MiB)).To(Equal(mirrorFQNs[0]))
+				}
+			})
+		})
+
+		Describe("DelAllCopiesBatch", func() {
+			prepareMirrored := func(n int) (loms []*cluster.LOM, copies []string) {
+				for i := 0; i < n; i++ {
+					objName := "batch/obj-" + strconv.Itoa(i)
+					lom := prepareLOM(findMpath(objName, bucketLocalC, true /*defaultLoc*/))
+					copyFQN := findMpath(objName, bucketLocalC, false /*defaultLoc*/)
+					_ = prepareCopy(lom, copyFQN)
+					loms = append(loms, NewBasicLom(lom.FQN))
+					copies = append(copies, copyFQN)
+				}
+				return
+			}
+
+			It("should remove all copies of all objects", func() {
+				loms, copies := prepareMirrored(5)
+				loms = append(loms, NewBasicLom(loms[0].FQN)) // duplicate
+
+				// busy: will be processed upon releasing the lock
+				busy := loms[1]
+				busy.Lock(false)
+				go func() {
+					time.Sleep(100 * time.Millisecond)
+					busy.Unlock(false)
+				}()
+
+				removed, err := cluster.DelAllCopiesBatch(context.Background(), loms)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(removed).To(Equal(len(copies)))
+				for i, copyFQN := range copies {
+					Expect(copyFQN).NotTo(BeAnExistingFile())
+					lom := NewBasicLom(loms[i].FQN)
+					Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+					Expect(lom.NumCopies()).To(Equal(1))
+				}
+			})
+
+			It("should stop upon cancellation", func() {
+				loms, copies := prepareMirrored(2)
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				removed, err := cluster.DelAllCopiesBatch(ctx, loms)
+				Expect(err).To(MatchError(context.Canceled))
+				Expect(removed).To(Equal(0))
+				for _, copyFQN := range copies {
+					Expect(copyFQN).To(BeARegularFile())
+				}
+			})
+		})
+
+		Describe("SyncCopiesMeta", func() {
+			It("should converge metadata across all copies of all objects", func() {
+				const numObjs = 20
+				var (
+					loms   []*cluster.LOM
+					copies []string
+				)
+				for i := 0; i < numObjs; i++ {
+					objName := "sync/obj-" + strconv.Itoa(i)
+					lom := prepareLOM(findMpath(objName, bucketLocalC, true /*defaultLoc*/))
+					copyFQN := findMpath(objName, bucketLocalC, false /*defaultLoc*/)
+					_ = prepareCopy(lom, copyFQN)
+					lom = NewBasicLom(lom.FQN)
+					Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+					Expect(lom.NumCopies()).To(Equal(2))
+					lom.SetCustomKey("sync", "v-"+strconv.Itoa(i))
+					loms = append(loms, lom)
+					copies = append(copies, copyFQN)
+				}
+				loms = append(loms, loms[0]) // duplicate
+
+				// busy: will be processed upon releasing the lock
+				busy := loms[1]
+				busy.Lock(false)
+				go func() {
+					time.Sleep(100 * time.Millisecond)
+					busy.Unlock(false)
+				}()
+
+				Expect(cluster.SyncCopiesMeta(loms)).NotTo(HaveOccurred())
+				for i := 0; i < numObjs; i++ {
+					for _, fqn := range []string{loms[i].FQN, copies[i]} {
+						lom := NewBasicLom(fqn)
+						Expect(lom.FromFS()).NotTo(HaveOccurred())
+						v, ok := lom.GetCustomKey("sync")
+						Expect(ok).To(BeTrue())
+						Expect(v).To(Equal("v-" + strconv.Itoa(i)))
+					}
+				}
+			})
+		})
+
+		Describe("RestoreToLocation", func() {
+			corrupt := func(fqn string) {
+				f, err := os.OpenFile(fqn, os.O_WRONLY, 0)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = f.WriteAt([]byte("corrupted"), 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(f.Close()).NotTo(HaveOccurred())
+			}
+			removeDefault := func(lom *cluster.LOM) {
+				Expect(os.Remove(lom.FQN)).NotTo(HaveOccurred())
+				lom.Uncache(true /*delDirty*/)
+			}
+
+			It("should restore object from a good copy", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				expectedHash := getTestFileHash(lom.FQN)
+				corrupt(mirrorFQNs[1])
+				removeDefault(lom)
 
-				// Reload default object and check if the lom was correctly updated.
 				lom = NewBasicLom(mirrorFQNs[0])
-				Expect(lom.Load(false, true)).ToNot(HaveOccurred())
-				Expect(lom.IsCopy()).To(BeFalse())
-				Expect(lom.HasCopies()).To(BeFalse())
-				Expect(lom.NumCopies()).To(Equal(1))
-				Expect(lom.GetCopies()).To(BeNil())
+				Expect(lom.RestoreToLocation()).To(BeTrue())
+				Expect(lom.FQN).To(BeARegularFile())
+				Expect(getTestFileHash(lom.FQN)).To(Equal(expectedHash))
 			})
 
-			It("should delete mirrored copy and update other copies", func() {
+			It("should not restore object from a corrupted copy", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				corrupt(mirrorFQNs[1])
+				removeDefault(lom)
+
+				lom = NewBasicLom(mirrorFQNs[0])
+				Expect(lom.RestoreToLocation()).To(BeFalse())
+				Expect(lom.FQN).NotTo(BeAnExistingFile())
+			})
+
+			It("should skip (not block on) the object that is locked", func() {
 				lom := prepareLOM(mirrorFQNs[0])
 				_ = prepareCopy(lom, mirrorFQNs[1])
 				_ = prepareCopy(lom, mirrorFQNs[2])
 				expectedHash := getTestFileHash(lom.FQN)
+				corrupt(mirrorFQNs[1])
+				removeDefault(lom)
 
-				// Check that copies were added to metadata.
-				lom.Lock(true)
-				defer lom.Unlock(true)
-				Expect(lom.IsCopy()).To(BeFalse())
-				Expect(lom.HasCopies()).To(BeTrue())
-				Expect(lom.NumCopies()).To(Equal(3))
-				Expect(lom.GetCopies()).To(And(HaveKey(mirrorFQNs[0]), HaveKey(mirrorFQNs[1]), HaveKey(mirrorFQNs[2])))
+				lom = NewBasicLom(mirrorFQNs[0])
+				lom.Lock(false)
+				started, exists := lom.TryRestoreToLocation()
+				lom.Unlock(false)
+				Expect(started).To(BeFalse())
+				Expect(exists).To(BeFalse())
+				Expect(lom.FQN).NotTo(BeAnExistingFile())
 
-				// Delete copy and check if it's gone.
-				Expect(lom.DelCopies(mirrorFQNs[1])).ToNot(HaveOccurred())
-				Expect(persist(lom)).ToNot(HaveOccurred())
-				Expect(mirrorFQNs[1]).NotTo(BeAnExistingFile())
+				started, exists = lom.TryRestoreToLocation()
+				Expect(started).To(BeTrue())
+				Expect(exists).To(BeTrue())
+				Expect(getTestFileHash(lom.FQN)).To(Equal(expectedHash))
+			})
 
-				// Reload default object and check if the lom was correctly updated.
+			It("should prefer the source that verifies when configured to verify sources", func() {
+				features := cmn.Features
+				defer func() {
+					cmn.Features = features
+					cluster.SetCopyFault(nil)
+				}()
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				expectedHash := getTestFileHash(lom.FQN)
+				corrupt(mirrorFQNs[1])
+				removeDefault(lom)
+
+				var tried []string
+				cluster.SetCopyFault(func(srcFQN, _ string) error {
+					tried = append(tried, srcFQN)
+					return nil
+				})
+				cmn.Features = cmn.Features.Set(feat.VerifyRestoreSources)
 				lom = NewBasicLom(mirrorFQNs[0])
-				Expect(lom.Load(false, true)).ToNot(HaveOccurred())
-				Expect(lom.IsCopy()).To(BeFalse())
-				Expect(lom.HasCopies()).To(BeTrue())
+				Expect(lom.RestoreToLocation()).To(BeTrue())
+				Expect(tried).To(Equal([]string{mirrorFQNs[2]}))
+				Expect(getTestFileHash(lom.FQN)).To(Equal(expectedHash))
+			})
+
+			Context("transient errors", func() {
+				var attempts int
+				failFirst := func(n int) {
+					attempts = 0
+					cluster.SetCopyFault(func(srcFQN, _ string) error {
+						attempts++
+						if attempts <= n {
+							return &os.PathError{Op: "read", Path: srcFQN, Err: syscall.EBUSY}
+						}
+						return nil
+					})
+				}
+				// (object names that haven't been used and cached by the other tests)
+				prepareMirrored := func(objName string) (lom *cluster.LOM) {
+					lom = prepareLOM(findMpath(objName, bucketLocalC, true /*defaultLoc*/))
+					_ = prepareCopy(lom, findMpath(objName, bucketLocalC, false /*defaultLoc*/))
+					return
+				}
+				AfterEach(func() { cluster.SetCopyFault(nil) })
+
+				It("should retry the only copy that fails once", func() {
+					lom := prepareMirrored("restore/fail-once")
+					expectedHash := getTestFileHash(lom.FQN)
+					removeDefault(lom)
+
+					failFirst(1)
+					lom = NewBasicLom(lom.FQN)
+					Expect(lom.RestoreToLocation()).To(BeTrue())
+					Expect(attempts).To(Equal(2))
+					Expect(getTestFileHash(lom.FQN)).To(Equal(expectedHash))
+				})
+
+				It("should give up after a bounded number of retries", func() {
+					lom := prepareMirrored("restore/fail-always")
+					removeDefault(lom)
+
+					failFirst(100)
+					lom = NewBasicLom(lom.FQN)
+					Expect(lom.RestoreToLocation()).To(BeFalse())
+					Expect(attempts).To(Equal(4)) // 1 + maxRestoreRetries
+					Expect(lom.FQN).NotTo(BeAnExistingFile())
+				})
+
+				It("should keep the object locked while backing off", func() {
+					lom := prepareMirrored("restore/backoff")
+					expectedHash := getTestFileHash(lom.FQN)
+					removeDefault(lom)
+
+					var (
+						other  = NewBasicLom(lom.FQN)
+						locked []bool
+					)
+					attempts = 0
+					cluster.SetCopyFault(func(srcFQN, _ string) error {
+						if attempts++; attempts > 1 {
+							// (retrying: whoever else must still be kept out)
+							locked = append(locked, !other.TryLock(true))
+							return nil
+						}
+						return &os.PathError{Op: "read", Path: srcFQN, Err: syscall.EBUSY}
+					})
+					lom = NewBasicLom(lom.FQN)
+					Expect(lom.RestoreToLocation()).To(BeTrue())
+					Expect(attempts).To(Equal(2))
+					Expect(locked).To(Equal([]bool{true}))
+					Expect(getTestFileHash(lom.FQN)).To(Equal(expectedHash))
+				})
+			})
+		})
+
+		Describe("ReplaceWithCopies", func() {
+			const newSize = 2 * testFileSize
+
+			prepareMirrored := func() (lom *cluster.LOM, newFQN string) {
+				lom = prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				newFQN = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut)
+				createTestFile(newFQN, newSize)
+				lom = NewBasicLom(mirrorFQNs[0])
+				return
+			}
+			reload := func(fqn string) *cluster.LOM {
+				lom := NewBasicLom(fqn)
+				lom.Uncache(false)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				return lom
+			}
+			AfterEach(func() {
+				cluster.SetCopyFault(nil)
+				cluster.SetRenameFault(nil)
+			})
+
+			It("should replace the object and all its copies", func() {
+				lom, newFQN := prepareMirrored()
+				expectedHash := getTestFileHash(newFQN)
+
+				Expect(lom.ReplaceWithCopies(newFQN, nil)).NotTo(HaveOccurred())
+				Expect(newFQN).NotTo(BeAnExistingFile())
+
+				lom = reload(mirrorFQNs[0])
+				Expect(lom.SizeBytes()).To(BeEquivalentTo(newSize))
+				Expect(lom.NumCopies()).To(Equal(3))
+				Expect(lom.ValidateContentChecksum()).NotTo(HaveOccurred())
+				for _, fqn := range mirrorFQNs {
+					Expect(getTestFileHash(fqn)).To(Equal(expectedHash))
+					cplom := reload(fqn)
+					Expect(cplom.Equal(lom)).To(BeTrue())
+					Expect(cplom.NumCopies()).To(Equal(3))
+				}
+			})
+
+			It("should roll back when failing to prepare the copies", func() {
+				lom, newFQN := prepareMirrored()
+				expectedHash := getTestFileHash(mirrorFQNs[0])
+
+				var prepared int
+				cluster.SetCopyFault(func(string, string) error {
+					if prepared++; prepared > 1 {
+						return errors.New("injected failure")
+					}
+					return nil
+				})
+				Expect(lom.ReplaceWithCopies(newFQN, nil)).To(HaveOccurred())
+				Expect(newFQN).To(BeARegularFile()) // (the caller's to clean up)
+
+				lom = reload(mirrorFQNs[0])
+				Expect(lom.SizeBytes()).To(BeEquivalentTo(testFileSize))
+				Expect(lom.Version()).To(Equal(desiredVersion))
+				Expect(lom.NumCopies()).To(Equal(3))
+				for _, fqn := range mirrorFQNs {
+					Expect(getTestFileHash(fqn)).To(Equal(expectedHash))
+				}
+				for _, mi := range mis {
+					workFQN := mi.MakePathFQN(lom.Bucket(), fs.WorkfileType, fs.WorkfileCopy+"."+lom.ObjName)
+					Expect(workFQN).NotTo(BeAnExistingFile())
+				}
+				checkCopies(lom, mirrorFQNs...)
+			})
+
+			It("should not leave any copy with the old content when failing in between copies", func() {
+				lom, newFQN := prepareMirrored()
+				expectedHash := getTestFileHash(newFQN)
+
+				var (
+					committed int
+					failed    string
+				)
+				cluster.SetRenameFault(func(_, copyFQN string) error {
+					// the object itself has been replaced by now; "crash" after the first copy
+					if committed++; committed > 1 {
+						failed = copyFQN
+						return errors.New("injected failure")
+					}
+					return nil
+				})
+				Expect(lom.ReplaceWithCopies(newFQN, nil)).NotTo(HaveOccurred())
+				Expect(failed).NotTo(BeEmpty())
+				Expect(failed).NotTo(BeAnExistingFile())
+
+				lom = reload(mirrorFQNs[0])
+				Expect(lom.SizeBytes()).To(BeEquivalentTo(newSize))
 				Expect(lom.NumCopies()).To(Equal(2))
-				Expect(lom.GetCopies()).To(And(HaveKey(mirrorFQNs[0]), HaveKey(mirrorFQNs[2])))
+				Expect(lom.GetCopies()).NotTo(HaveKey(failed))
+				for fqn := range lom.GetCopies() {
+					Expect(getTestFileHash(fqn)).To(Equal(expectedHash))
+					Expect(reload(fqn).Equal(lom)).To(BeTrue())
+				}
+			})
+		})
 
-				// Check that left copy was correctly updated.
-				copyLOM := NewBasicLom(mirrorFQNs[2])
-				Expect(copyLOM.Load(false, true)).NotTo(HaveOccurred())
-				_, cksumValue := copyLOM.Checksum().Get()
-				Expect(cksumValue).To(Equal(expectedHash))
-				Expect(copyLOM.Version()).To(Equal(desiredVersion))
-				Expect(copyLOM.SizeBytes()).To(BeEquivalentTo(testFileSize))
+		Describe("AuditMpathCopies", func() {
+			audit := func(ctx context.Context) (orphans, dangling []string) {
+				for _, mpath := range mpaths {
+					o, d, err := cluster.AuditMpathCopies(ctx, mpath)
+					Expect(err).NotTo(HaveOccurred())
+					for _, fqn := range o {
+						Expect(fqn).To(HavePrefix(mpath + "/"))
+					}
+					orphans = append(orphans, o...)
+					dangling = append(dangling, d...)
+				}
+				return
+			}
 
-				Expect(copyLOM.IsCopy()).To(BeTrue())
-				Expect(copyLOM.HasCopies()).To(BeTrue())
-				Expect(copyLOM.NumCopies()).To(Equal(lom.NumCopies()))
-				Expect(copyLOM.GetCopies()).To(Equal(lom.GetCopies()))
+			It("should report nothing when copies and metadata are consistent", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+
+				orphans, dangling := audit(context.Background())
+				Expect(orphans).To(BeEmpty())
+				Expect(dangling).To(BeEmpty())
+			})
+
+			It("should report orphaned and dangling copies without changing anything", func() {
+				// dangling: copy removed behind the object's back
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				Expect(os.Remove(mirrorFQNs[2])).NotTo(HaveOccurred())
+
+				// orphan: the object does not exist
+				const orphanName = "audit/no-object"
+				orphanFQN := findMpath(orphanName, bucketLocalC, false /*defaultLoc*/)
+				createTestFile(orphanFQN, testFileSize)
+
+				// orphan: the object exists but does not claim the copy
+				const unclaimedName = "audit/unclaimed"
+				unclaimed := prepareLOM(findMpath(unclaimedName, bucketLocalC, true /*defaultLoc*/))
+				unclaimedFQN := findMpath(unclaimedName, bucketLocalC, false /*defaultLoc*/)
+				_ = prepareCopy(unclaimed, unclaimedFQN)
+				unclaimed = NewBasicLom(unclaimed.FQN)
+				unclaimed.Lock(true)
+				Expect(unclaimed.Load(false, true)).NotTo(HaveOccurred())
+				cluster.SetCopiesMD(unclaimed, nil)
+				Expect(persist(unclaimed)).NotTo(HaveOccurred())
+				unclaimed.Unlock(true)
+
+				orphans, dangling := audit(context.Background())
+				Expect(orphans).To(ConsistOf(orphanFQN, unclaimedFQN))
+				Expect(dangling).To(ConsistOf(mirrorFQNs[2]))
+
+				// read-only
+				Expect(orphanFQN).To(BeARegularFile())
+				Expect(unclaimedFQN).To(BeARegularFile())
+				lom = NewBasicLom(mirrorFQNs[0])
+				lom.Uncache(false)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(3))
+			})
+
+			It("should stop upon cancellation", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				Expect(os.Remove(mirrorFQNs[1])).NotTo(HaveOccurred())
+
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				for _, mpath := range mpaths {
+					_, dangling, err := cluster.AuditMpathCopies(ctx, mpath)
+					Expect(err).To(MatchError(context.Canceled))
+					Expect(dangling).To(BeEmpty())
+				}
+			})
+
+			It("should fail on unknown mountpath", func() {
+				_, _, err := cluster.AuditMpathCopies(context.Background(), tmpDir+"/unknown")
+				Expect(cmn.IsErrMountpathNotFound(err)).To(BeTrue())
+			})
+		})
+
+		Describe("LeastUtilNoCopy", func() {
+			It("should deterministically distribute copies across equally utilized mountpaths", func() {
+				const numObjs = 300
+				var (
+					bck    = cmn.Bck{Name: bucketLocalC, Provider: apc.AIS, Ns: cmn.NsGlobal}
+					placed = make(map[string]int, numMpaths)
+				)
+				for i := 0; i < numObjs; i++ {
+					lom := &cluster.LOM{ObjName: fmt.Sprintf("%s-%d", testObjectName, i)}
+					Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+					mi := lom.LeastUtilNoCopy()
+					Expect(mi).NotTo(BeNil())
+					Expect(mi.Path).NotTo(Equal(lom.MpathInfo().Path))
+					for j := 0; j < 3; j++ {
+						Expect(lom.LeastUtilNoCopy().Path).To(Equal(mi.Path))
+					}
+					placed[mi.Path]++
+				}
+				Expect(placed).To(HaveLen(numMpaths))
+				for mpath, cnt := range placed {
+					Expect(cnt).To(BeNumerically(">", numObjs/(2*numMpaths)), mpath)
+				}
+			})
+
+			It("should avoid mountpaths below the free capacity watermark", func() {
+				const numObjs = 100
+				var (
+					bck            = cmn.Bck{Name: bucketLocalC, Provider: apc.AIS, Ns: cmn.NsGlobal}
+					availablePaths = fs.GetAvail()
+					nearFull       = mpaths[0]
+				)
+				config := cmn.GCO.BeginUpdate()
+				config.Space.MirrorMinFree = 10
+				cmn.GCO.CommitUpdate(config)
+				defer func() {
+					config := cmn.GCO.BeginUpdate()
+					config.Space.MirrorMinFree = 0
+					cmn.GCO.CommitUpdate(config)
+					for _, mi := range availablePaths {
+						mi.TestSetCapacity(fs.Capacity{})
+					}
+				}()
+				availablePaths[nearFull].TestSetCapacity(fs.Capacity{Used: 95 * cos.GiB, Avail: 5 * cos.GiB, PctUsed: 95})
+
+				for i := 0; i < numObjs; i++ {
+					lom := &cluster.LOM{ObjName: fmt.Sprintf("%s-%d", testObjectName, i)}
+					Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+					mi := lom.LeastUtilNoCopy()
+					Expect(mi).NotTo(BeNil())
+					Expect(mi.Path).NotTo(Equal(nearFull))
+				}
+
+				// all below the watermark: the most free space wins
+				for i, mpath := range mpaths {
+					pct := int32(91 + i)
+					availablePaths[mpath].TestSetCapacity(fs.Capacity{
+						Used:    uint64(pct) * cos.GiB,
+						Avail:   uint64(100-pct) * cos.GiB,
+						PctUsed: pct,
+					})
+				}
+				for i := 0; i < numObjs; i++ {
+					lom := &cluster.LOM{ObjName: fmt.Sprintf("%s-%d", testObjectName, i)}
+					Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+					expected := mpaths[0]
+					if lom.MpathInfo().Path == expected {
+						expected = mpaths[1]
+					}
+					Expect(lom.LeastUtilNoCopy().Path).To(Equal(expected))
+				}
+			})
+
+			Context("mirror.mpaths", func() {
+				const numObjs = 100
+				var bck = cmn.Bck{Name: bucketLocalC, Provider: apc.AIS, Ns: cmn.NsGlobal}
+
+				// place copies of `numObjs` objects subject to a given allowlist
+				placeWith := func(allowed []string) (placed map[string]int, none int) {
+					placed = make(map[string]int, numMpaths)
+					for i := 0; i < numObjs; i++ {
+						lom := &cluster.LOM{ObjName: fmt.Sprintf("%s-%d", testObjectName, i)}
+						Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+						mirror := lom.MirrorConf()
+						mirror.Mpaths = allowed
+						mi := lom.LeastUtilNoCopy()
+						mirror.Mpaths = nil
+						if mi == nil {
+							none++
+							continue
+						}
+						Expect(mi.Path).NotTo(Equal(lom.MpathInfo().Path))
+						placed[mi.Path]++
+					}
+					return
+				}
+
+				It("should use any mountpath when all are allowed", func() {
+					placed, none := placeWith(mpaths)
+					Expect(none).To(BeZero())
+					Expect(placed).To(HaveLen(numMpaths))
+				})
+
+				It("should use only the allowed mountpaths", func() {
+					allowed := mpaths[1:]
+					placed, none := placeWith(allowed)
+					for mpath := range placed {
+						Expect(allowed).To(ContainElement(mpath))
+					}
+					// objects that are HRW-located on one of the two allowed mountpaths
+					// can still be copied onto the other one
+					Expect(none).To(BeZero())
+				})
+
+				It("should not create copies when none is allowed", func() {
+					placed, none := placeWith([]string{tmpDir + "/other"})
+					Expect(placed).To(BeEmpty())
+					Expect(none).To(Equal(numObjs))
+				})
+			})
+
+			Context("failure domains", func() {
+				const numObjs = 100
+				var (
+					bck      = cmn.Bck{Name: bucketLocalC, Provider: apc.AIS, Ns: cmn.NsGlobal}
+					features feat.Flags
+				)
+				mpathOf := func(fqn string) *fs.MountpathInfo {
+					for mpath, mi := range fs.GetAvail() {
+						if strings.HasPrefix(fqn, mpath+"/") {
+							return mi
+						}
+					}
+					return nil
+				}
+
+				// the default (HRW) location of the test object is alone in its domain,
+				// while the other two mountpaths share the other one
+				BeforeEach(func() {
+					features = cmn.Features
+					for _, mi := range fs.GetAvail() {
+						mi.Domain = "encl-a"
+					}
+					mpathOf(mirrorFQNs[0]).Domain = "encl-b"
+				})
+				AfterEach(func() {
+					cmn.Features = features
+					for _, mi := range fs.GetAvail() {
+						mi.Domain = ""
+					}
+				})
+
+				It("should place copies in a different failure domain", func() {
+					placed := make(map[string]int, 2)
+					for i := 0; i < numObjs; i++ {
+						lom := &cluster.LOM{ObjName: fmt.Sprintf("%s-%d", testObjectName, i)}
+						Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+						mi := lom.LeastUtilNoCopy()
+						Expect(mi).NotTo(BeNil())
+						Expect(mi.Domain).NotTo(Equal(lom.MpathInfo().Domain))
+						placed[mi.Domain]++
+					}
+					Expect(placed).To(HaveLen(2))
+				})
+
+				It("should place anyway or refuse when only the same domain remains", func() {
+					lom := prepareLOM(mirrorFQNs[0])
+					_ = prepareCopy(lom, mirrorFQNs[1])
+					lom = NewBasicLom(mirrorFQNs[0])
+					Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+					Expect(lom.NumCopies()).To(Equal(2))
+
+					mi := lom.LeastUtilNoCopy()
+					Expect(mi).NotTo(BeNil())
+					Expect(mi.Path).To(Equal(mpathOf(mirrorFQNs[2]).Path))
+
+					cmn.Features = cmn.Features.Set(feat.StrictCopyAntiAffinity)
+					Expect(lom.LeastUtilNoCopy()).To(BeNil())
+				})
+			})
+		})
+
+		Describe("RegPlacementCB", func() {
+			It("should observe the chosen mountpath and the candidates", func() {
+				const numObjs = 50
+				var (
+					bck      = cmn.Bck{Name: bucketLocalC, Provider: apc.AIS, Ns: cmn.NsGlobal}
+					observed int
+					chosen   *fs.MountpathInfo
+					cands    fs.MPI
+				)
+				cluster.RegPlacementCB(func(_ *cluster.LOM, mi *fs.MountpathInfo, candidates fs.MPI) {
+					observed++
+					chosen, cands = mi, candidates
+				})
+				defer cluster.RegPlacementCB(nil)
+
+				for i := 0; i < numObjs; i++ {
+					lom := &cluster.LOM{ObjName: fmt.Sprintf("%s-%d", testObjectName, i)}
+					Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+					mi := lom.LeastUtilNoCopy()
+					Expect(mi).NotTo(BeNil())
+					Expect(observed).To(Equal(i + 1))
+					Expect(chosen).To(Equal(mi))
+					Expect(cands).To(HaveKey(mi.Path))
+					Expect(cands).NotTo(HaveKey(lom.MpathInfo().Path)) // already has it
+					Expect(cands).To(HaveLen(numMpaths - 1))
+				}
+			})
+
+			It("should not change placement", func() {
+				const numObjs = 50
+				var (
+					bck      = cmn.Bck{Name: bucketLocalC, Provider: apc.AIS, Ns: cmn.NsGlobal}
+					expected = make([]string, 0, numObjs)
+				)
+				for i := 0; i < numObjs; i++ {
+					lom := &cluster.LOM{ObjName: fmt.Sprintf("%s-%d", testObjectName, i)}
+					Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+					expected = append(expected, lom.LeastUtilNoCopy().Path)
+				}
+				cluster.RegPlacementCB(func(*cluster.LOM, *fs.MountpathInfo, fs.MPI) {})
+				defer cluster.RegPlacementCB(nil)
+				for i := 0; i < numObjs; i++ {
+					lom := &cluster.LOM{ObjName: fmt.Sprintf("%s-%d", testObjectName, i)}
+					Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+					Expect(lom.LeastUtilNoCopy().Path).To(Equal(expected[i]))
+				}
+			})
+		})
+
+		Describe("IsOverReplicated", func() {
+			It("should compare the number of copies with mirror config", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				over, excess := lom.IsOverReplicated()
+				Expect(over).To(BeFalse())
+				Expect(excess).To(Equal(0))
+
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				lom = NewBasicLom(mirrorFQNs[0])
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				over, excess = lom.IsOverReplicated()
+				Expect(over).To(BeTrue())
+				Expect(excess).To(Equal(1))
+			})
+
+			It("should return false when mirroring is disabled", func() {
+				lom := prepareLOM(copyFQNs[0])
+				_ = prepareCopy(lom, copyFQNs[1])
+				over, _ := lom.IsOverReplicated()
+				Expect(over).To(BeFalse())
+			})
+		})
+
+		Describe("PhysicalSize", func() {
+			load := func(fqn string) *cluster.LOM {
+				lom := NewBasicLom(fqn)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				return lom
+			}
+
+			It("should return the logical size when there are no copies", func() {
+				lom := load(prepareLOM(mirrorFQNs[0]).FQN)
+				Expect(lom.PhysicalSize(false)).To(BeEquivalentTo(testFileSize))
+				Expect(lom.PhysicalSize(true)).To(BeEquivalentTo(testFileSize))
+			})
+
+			It("should add up all copies", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				lom = load(mirrorFQNs[0])
+				Expect(lom.PhysicalSize(false)).To(BeEquivalentTo(3 * testFileSize))
+				Expect(lom.PhysicalSize(true)).To(BeEquivalentTo(3 * testFileSize))
+			})
+
+			It("should only count existing copies when verifying", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+
+				// stale metadata: one copy is gone and another one got truncated
+				Expect(os.Remove(mirrorFQNs[1])).NotTo(HaveOccurred())
+				Expect(os.Truncate(mirrorFQNs[2], testFileSize/2)).NotTo(HaveOccurred())
+
+				lom = load(mirrorFQNs[0])
+				Expect(lom.PhysicalSize(false)).To(BeEquivalentTo(3 * testFileSize))
+				Expect(lom.PhysicalSize(true)).To(BeEquivalentTo(testFileSize + testFileSize/2))
+			})
+		})
+
+		Describe("EvacuateCopiesFrom", func() {
+			// mark the mountpath as being detached (undone by re-enabling)
+			drain := func(mpath string) {
+				mi, _, err := fs.BeginDD(apc.ActMountpathDetach, fs.FlagBeingDetached, mpath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mi).NotTo(BeNil())
+			}
+			undrain := func(mpath string) {
+				_, err := fs.Enable(mpath)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			evacuate := func(lom *cluster.LOM, mpath string) (moved int, err error) {
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				if moved, err = lom.EvacuateCopiesFrom(mpath, make([]byte, testFileSize)); err == nil {
+					Expect(lom.Persist()).NotTo(HaveOccurred())
+				}
+				return
+			}
+
+			It("should move the copy to a healthy mountpath", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				cplom := prepareCopy(lom, mirrorFQNs[1])
+				mpath := cplom.MpathInfo().Path
+				lom = NewBasicLom(mirrorFQNs[0])
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+
+				drain(mpath)
+				defer undrain(mpath)
+				moved, err := evacuate(lom, mpath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(moved).To(Equal(1))
+				Expect(mirrorFQNs[1]).NotTo(BeAnExistingFile())
+
+				lom = NewBasicLom(mirrorFQNs[0])
+				lom.Uncache(true /*delDirty*/)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(2))
+				lom.Lock(false)
+				for fqn, mi := range lom.GetCopies() {
+					Expect(mi.Path).NotTo(Equal(mpath))
+					Expect(fqn).To(BeARegularFile())
+				}
+				lom.Unlock(false)
+			})
+
+			It("should fail and keep the copy when there's no room elsewhere", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				cplom := prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				mpath := cplom.MpathInfo().Path
+				lom = NewBasicLom(mirrorFQNs[0])
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+
+				drain(mpath)
+				defer undrain(mpath)
+				moved, err := evacuate(lom, mpath)
+				Expect(err).To(HaveOccurred())
+				Expect(moved).To(BeZero())
+				Expect(mirrorFQNs[1]).To(BeARegularFile())
+				Expect(lom.NumCopies()).To(Equal(3))
+			})
+
+			It("should be a no-op when there are no copies on the mountpath", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				cplom := prepareCopy(lom, mirrorFQNs[1])
+				lom = NewBasicLom(mirrorFQNs[0])
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				moved, err := evacuate(lom, lom.MpathInfo().Path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(moved).To(BeZero())
+				Expect(cplom.FQN).To(BeARegularFile())
+			})
+		})
+
+		Describe("RebalanceCopies", func() {
+			spread := func(counts map[string]int64) (spread, total int64) {
+				minCnt, maxCnt := int64(math.MaxInt64), int64(math.MinInt64)
+				for _, mpath := range mpaths {
+					minCnt, maxCnt = cos.MinI64(minCnt, counts[mpath]), cos.MaxI64(maxCnt, counts[mpath])
+					total += counts[mpath]
+				}
+				return maxCnt - minCnt, total
+			}
+
+			It("should move copies off the mountpath that holds too many", func() {
+				const numObjs = 12
+				var (
+					heavy  string
+					loms   []*cluster.LOM
+					buf    = make([]byte, testFileSize)
+					counts = fs.CopyCountByMpath()
+				)
+				for _, mpath := range mpaths {
+					if heavy == "" || counts[mpath] > counts[heavy] {
+						heavy = mpath
+					}
+				}
+				// skewed placement: all copies on the (already) heaviest mountpath
+				mi := fs.GetAvail()[heavy]
+				for i := 0; len(loms) < numObjs; i++ {
+					objName := "rebalance/obj-" + strconv.Itoa(i)
+					fqn := findMpath(objName, bucketLocalC, true /*defaultLoc*/)
+					if strings.HasPrefix(fqn, heavy+"/") {
+						continue
+					}
+					lom := prepareLOM(fqn)
+					lom.Lock(true)
+					Expect(lom.Copy(mi, buf)).NotTo(HaveOccurred())
+					lom.Unlock(true)
+					loms = append(loms, lom)
+				}
+				before, total := spread(fs.CopyCountByMpath())
+
+				var moved int
+				for _, lom := range loms {
+					n, err := NewBasicLom(lom.FQN).RebalanceCopies(buf)
+					Expect(err).NotTo(HaveOccurred())
+					moved += n
+				}
+				Expect(moved).To(BeNumerically(">", 0))
+				after, totalAfter := spread(fs.CopyCountByMpath())
+				Expect(after).To(BeNumerically("<", before))
+				Expect(totalAfter).To(Equal(total))
+
+				for _, lom := range loms {
+					lom = NewBasicLom(lom.FQN)
+					Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+					Expect(lom.NumCopies()).To(Equal(2))
+					for fqn := range lom.GetCopies() {
+						Expect(fqn).To(BeARegularFile())
+					}
+				}
+
+				// (idempotent) nothing left to improve
+				for _, lom := range loms {
+					n, err := NewBasicLom(lom.FQN).RebalanceCopies(buf)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(n).To(BeZero())
+				}
+			})
+
+			It("should keep pinned copies in place", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				lom = NewBasicLom(mirrorFQNs[0])
+				lom.Lock(true)
+				Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+				Expect(lom.PinCopy(mirrorFQNs[1])).NotTo(HaveOccurred())
+				Expect(lom.Persist()).NotTo(HaveOccurred())
+				lom.Unlock(true)
+
+				moved, err := NewBasicLom(mirrorFQNs[0]).RebalanceCopies(make([]byte, testFileSize))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(moved).To(BeZero())
+				Expect(mirrorFQNs[1]).To(BeARegularFile())
+			})
+		})
+
+		Describe("PinCopy", func() {
+			// (mountpath going away changes HRW - see EvacuateCopiesFrom above)
+			drain := func(mpath string) {
+				mi, _, err := fs.BeginDD(apc.ActMountpathDetach, fs.FlagBeingDetached, mpath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mi).NotTo(BeNil())
+			}
+			undrain := func(mpath string) {
+				_, err := fs.Enable(mpath)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			load := func(fqn string) *cluster.LOM {
+				lom := NewBasicLom(fqn)
+				lom.Uncache(true /*delDirty*/)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				return lom
+			}
+			pin := func(fqn, copyFQN string) {
+				lom := load(fqn)
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.PinCopy(copyFQN)).NotTo(HaveOccurred())
+				Expect(lom.Persist()).NotTo(HaveOccurred())
+			}
+
+			It("should persist the pin in all copies", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				pin(mirrorFQNs[0], mirrorFQNs[1])
+
+				Expect(load(mirrorFQNs[0]).IsPinned(mirrorFQNs[1])).To(BeTrue())
+				Expect(load(mirrorFQNs[1]).IsPinned(mirrorFQNs[1])).To(BeTrue())
+				Expect(load(mirrorFQNs[0]).IsPinned(mirrorFQNs[0])).To(BeFalse())
+			})
+
+			It("should only pin copies", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				lom = load(mirrorFQNs[0])
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.PinCopy(mirrorFQNs[0])).To(HaveOccurred())
+				Expect(lom.PinCopy(mirrorFQNs[2])).To(HaveOccurred())
+			})
+
+			It("should retain pinned copy through HRW change", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				pin(mirrorFQNs[0], mirrorFQNs[1])
+
+				pinnedMpath := load(mirrorFQNs[1]).MpathInfo().Path
+				otherMpath := load(mirrorFQNs[2]).MpathInfo().Path
+				drain(pinnedMpath)
+				defer undrain(pinnedMpath)
+				drain(otherMpath)
+				defer undrain(otherMpath)
+
+				lom = load(mirrorFQNs[0])
+				lom.Lock(true)
+				_, _ = lom.ToMpath()
+				Expect(lom.GetCopies()).To(HaveKey(mirrorFQNs[1]))
+				Expect(lom.GetCopies()).NotTo(HaveKey(mirrorFQNs[2]))
+				Expect(lom.IsPinned(mirrorFQNs[1])).To(BeTrue())
+
+				moved, err := lom.EvacuateCopiesFrom(pinnedMpath, make([]byte, testFileSize))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(moved).To(BeZero())
+				Expect(lom.Persist()).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(mirrorFQNs[1]).To(BeARegularFile())
+
+				undrain(pinnedMpath)
+				lom = load(mirrorFQNs[0])
+				Expect(lom.GetCopies()).To(HaveKey(mirrorFQNs[1]))
+				Expect(lom.IsPinned(mirrorFQNs[1])).To(BeTrue())
+			})
+
+			It("should move unpinned copy", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				pin(mirrorFQNs[0], mirrorFQNs[1])
+
+				lom = load(mirrorFQNs[0])
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.UnpinCopy(mirrorFQNs[1])).NotTo(HaveOccurred())
+				Expect(lom.IsPinned(mirrorFQNs[1])).To(BeFalse())
+
+				mpath := lom.GetCopies()[mirrorFQNs[1]].Path
+				drain(mpath)
+				defer undrain(mpath)
+				moved, err := lom.EvacuateCopiesFrom(mpath, make([]byte, testFileSize))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(moved).To(Equal(1))
+				Expect(mirrorFQNs[1]).NotTo(BeAnExistingFile())
+			})
+
+			It("should drop the pin along with the copy", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				pin(mirrorFQNs[0], mirrorFQNs[1])
+
+				lom = load(mirrorFQNs[0])
+				lom.Lock(true)
+				Expect(lom.DelCopies(mirrorFQNs[1])).NotTo(HaveOccurred())
+				Expect(lom.Persist()).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(load(mirrorFQNs[0]).IsPinned(mirrorFQNs[1])).To(BeFalse())
+			})
+		})
+
+		Describe("ForEachCopy", func() {
+			It("should iterate self when there are no copies", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				var fqns []string
+				err := lom.ForEachCopy(func(fqn string, mi *fs.MountpathInfo) error {
+					Expect(mi).NotTo(BeNil())
+					fqns = append(fqns, fqn)
+					return nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fqns).To(Equal([]string{mirrorFQNs[0]}))
+			})
+
+			It("should iterate all copies in order and allow taking the lock", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				lom = NewBasicLom(mirrorFQNs[0])
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+
+				var fqns []string
+				err := lom.ForEachCopy(func(fqn string, mi *fs.MountpathInfo) error {
+					Expect(fqn).To(HavePrefix(mi.Path))
+					lom.Lock(true) // must not deadlock
+					lom.Unlock(true)
+					fqns = append(fqns, fqn)
+					return nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fqns).To(ConsistOf(mirrorFQNs))
+				Expect(sort.StringsAreSorted(fqns)).To(BeTrue())
+			})
+
+			It("should stop on the first error", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				lom = NewBasicLom(mirrorFQNs[0])
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+
+				var (
+					cnt     int
+					errStop = errors.New("stop")
+				)
+				err := lom.ForEachCopy(func(string, *fs.MountpathInfo) error {
+					cnt++
+					return errStop
+				})
+				Expect(err).To(Equal(errStop))
+				Expect(cnt).To(Equal(1))
+			})
+		})
+
+		Describe("lazy checksum", func() {
+			lazyDone := func() int64 {
+				Eventually(func() int64 {
+					pending, _ := cluster.LazyCksumStats()
+					return pending
+				}, 5*time.Second, 10*time.Millisecond).Should(BeZero())
+				_, bad := cluster.LazyCksumStats()
+				return bad
+			}
+
+			It("should keep a valid copy", func() {
+				var (
+					objName = "lazy/good"
+					fqn     = findMpath(objName, bucketLocalG, true /*defaultLoc*/)
+					copyFQN = findMpath(objName, bucketLocalG, false /*defaultLoc*/)
+				)
+				lom := filePut(fqn, testFileSize)
+				badBefore := lazyDone()
+
+				lom.Lock(true)
+				Expect(lom.CopyToFQN(copyFQN, make([]byte, testFileSize))).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(lazyDone()).To(Equal(badBefore))
+
+				lom = NewBasicLom(fqn)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(2))
+				Expect(copyFQN).To(BeARegularFile())
+			})
+
+			It("should remove a copy that fails verification", func() {
+				var (
+					objName = "lazy/bad"
+					fqn     = findMpath(objName, bucketLocalG, true /*defaultLoc*/)
+					copyFQN = findMpath(objName, bucketLocalG, false /*defaultLoc*/)
+				)
+				lom := filePut(fqn, testFileSize)
+				badBefore := lazyDone()
+
+				// stored checksum that the content (and so the copy) does not match
+				lom.Lock(true)
+				lom.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "0123456789abcdef"))
+				Expect(lom.Persist()).NotTo(HaveOccurred())
+				Expect(lom.CopyToFQN(copyFQN, make([]byte, testFileSize))).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(lazyDone()).To(Equal(badBefore + 1))
+
+				lom = NewBasicLom(fqn)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(1))
+				Expect(copyFQN).NotTo(BeAnExistingFile())
+			})
+		})
+
+		Describe("VerifyMeta", func() {
+			mpathOf := func(fqn string) *fs.MountpathInfo {
+				parsed, err := fs.ParseFQN(fqn)
+				Expect(err).NotTo(HaveOccurred())
+				return parsed.MpathInfo
+			}
+
+			It("should pass for consistent metadata", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				Expect(lom.VerifyMeta()).NotTo(HaveOccurred())
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				Expect(lom.VerifyMeta()).NotTo(HaveOccurred())
+			})
+
+			It("should detect a copy in place of the object", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				cpy := prepareCopy(lom, mirrorFQNs[1])
+				Expect(cpy.VerifyMeta()).To(MatchError(ContainSubstring("is a copy")))
+			})
+
+			It("should detect the object missing from its copies", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				cluster.SetCopiesMD(lom, fs.MPI{
+					mirrorFQNs[1]: mpathOf(mirrorFQNs[1]),
+					mirrorFQNs[2]: mpathOf(mirrorFQNs[2]),
+				})
+				Expect(lom.VerifyMeta()).To(MatchError(ContainSubstring("do not include the object itself")))
+			})
+
+			It("should detect duplicate mountpaths", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				cluster.SetCopiesMD(lom, fs.MPI{
+					mirrorFQNs[0]: mpathOf(mirrorFQNs[0]),
+					mirrorFQNs[1]: mpathOf(mirrorFQNs[0]),
+				})
+				Expect(lom.VerifyMeta()).To(MatchError(ContainSubstring("duplicate mountpath")))
+			})
+
+			It("should detect mountpath mismatch", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				cluster.SetCopiesMD(lom, fs.MPI{
+					mirrorFQNs[0]: mpathOf(mirrorFQNs[0]),
+					mirrorFQNs[1]: mpathOf(mirrorFQNs[2]),
+				})
+				Expect(lom.VerifyMeta()).To(MatchError(ContainSubstring("mountpath mismatch")))
+			})
+
+			It("should detect a copy of a different object", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				other := copyFQNs[0] // same name, different bucket (and mountpath)
+				if mpathOf(other).Path == mpathOf(mirrorFQNs[0]).Path {
+					other = copyFQNs[1]
+				}
+				cluster.SetCopiesMD(lom, fs.MPI{
+					mirrorFQNs[0]: mpathOf(mirrorFQNs[0]),
+					other:         mpathOf(other),
+				})
+				Expect(lom.VerifyMeta()).To(MatchError(ContainSubstring("resolves to a different object")))
+			})
+		})
+
+		Describe("CopyToFQN", func() {
+			It("should add mirror copy at the specified FQN", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.CopyToFQN(mirrorFQNs[1], make([]byte, testFileSize))).NotTo(HaveOccurred())
+				Expect(mirrorFQNs[1]).To(BeARegularFile())
+				Expect(lom.NumCopies()).To(Equal(2))
+				Expect(lom.GetCopies()).To(And(HaveKey(mirrorFQNs[0]), HaveKey(mirrorFQNs[1])))
+
+				// already has a copy on that mountpath
+				Expect(lom.CopyToFQN(mirrorFQNs[1], make([]byte, testFileSize))).To(HaveOccurred())
+			})
+
+			It("should reject destinations that resolve to a different object", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				createTestFile(renamedObjFQN, testFileSize)
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				for _, fqn := range []string{copyFQNs[1], renamedObjFQN, lom.FQN} {
+					Expect(lom.CopyToFQN(fqn, make([]byte, testFileSize))).To(HaveOccurred())
+				}
+				Expect(lom.NumCopies()).To(Equal(1))
+			})
+
+			It("should copy to arbitrary destinations without registering them", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				mi, _, err := fs.FQN2Mpath(mirrorFQNs[1])
+				Expect(err).NotTo(HaveOccurred())
+				before := fs.CopyCountByMpath()
+
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				for _, fqn := range []string{
+					filepath.Join(tmpDir, "export", "obj"),         // outside mountpaths
+					filepath.Join(mi.Path, "export", "sub", "obj"), // on a mountpath but not an object
+				} {
+					Expect(lom.CopyToFQN(fqn, make([]byte, testFileSize))).NotTo(HaveOccurred())
+					Expect(fqn).To(BeARegularFile())
+					b, err := os.ReadFile(fqn)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(b).To(HaveLen(testFileSize))
+
+					// (overwrite)
+					Expect(lom.CopyToFQN(fqn, make([]byte, testFileSize))).NotTo(HaveOccurred())
+				}
+				Expect(lom.NumCopies()).To(Equal(1))
+				Expect(fs.CopyCountByMpath()).To(Equal(before))
+			})
+		})
+
+		Describe("free space check", func() {
+			It("should fail fast when the destination cannot fit the copy", func() {
+				var (
+					bck      = cmn.Bck{Name: bucketLocalC, Provider: apc.AIS, Ns: cmn.NsGlobal}
+					buf      = make([]byte, testFileSize)
+					features = cmn.Features
+				)
+				lom := prepareLOM(mirrorFQNs[0])
+				parsed, err := fs.ParseFQN(mirrorFQNs[1])
+				Expect(err).NotTo(HaveOccurred())
+				mi := parsed.MpathInfo
+				mi.TestSetCapacity(fs.Capacity{Used: 100 * cos.GiB, Avail: testFileSize - 1, PctUsed: 99})
+				defer func() {
+					mi.TestSetCapacity(fs.Capacity{})
+					cmn.Features = features
+				}()
+
+				lom.Lock(true)
+				err = lom.Copy(mi, buf)
+				Expect(cos.IsErrOOS(err)).To(BeTrue())
+				dst, err := lom.Copy2FQN(mirrorFQNs[1], buf)
+				Expect(cos.IsErrOOS(err)).To(BeTrue())
+				Expect(dst).To(BeNil())
+				lom.Unlock(true)
+
+				Expect(mirrorFQNs[1]).NotTo(BeAnExistingFile())
+				Expect(lom.NumCopies()).To(Equal(1))
+				stale, err := fs.StaleWorkfiles(&bck, fs.WorkfileCopy, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stale).To(BeEmpty())
+
+				// never chosen for placement
+				for i := 0; i < 50; i++ {
+					lom := &cluster.LOM{ObjName: fmt.Sprintf("%s-%d", testObjectName, i)}
+					Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+					lom.SetSize(testFileSize)
+					if cmi := lom.LeastUtilNoCopy(); cmi != nil {
+						Expect(cmi.Path).NotTo(Equal(mi.Path))
+					}
+				}
+
+				// unless disabled
+				cmn.Features = cmn.Features.Set(feat.SkipCopySpaceCheck)
+				lom.Lock(true)
+				Expect(lom.Copy(mi, buf)).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(mirrorFQNs[1]).To(BeARegularFile())
+				Expect(lom.NumCopies()).To(Equal(2))
+			})
+		})
+
+		Describe("EnsureCopies", func() {
+			ensureCopies := func(lom *cluster.LOM) int {
+				lom = NewBasicLom(lom.FQN)
+				created, err := lom.EnsureCopies(make([]byte, testFileSize))
+				Expect(err).NotTo(HaveOccurred())
+				return created
+			}
+			numCopies := func(lom *cluster.LOM) int {
+				lom = NewBasicLom(lom.FQN)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				return lom.NumCopies()
+			}
+
+			It("should add missing copies", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				Expect(ensureCopies(lom)).To(Equal(1))
+				Expect(numCopies(lom)).To(Equal(2))
+
+				// idempotent
+				Expect(ensureCopies(lom)).To(Equal(0))
+				Expect(numCopies(lom)).To(Equal(2))
+			})
+
+			It("should not change fully replicated object", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				Expect(ensureCopies(lom)).To(Equal(0))
+				Expect(numCopies(lom)).To(Equal(2))
+			})
+
+			It("should not remove extra copies", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				Expect(ensureCopies(lom)).To(Equal(0))
+				Expect(numCopies(lom)).To(Equal(3))
+			})
+
+			It("should place as many copies as there are mountpaths", func() {
+				lom := prepareLOM(findMpath(testObjectName, bucketLocalE, true /*defaultLoc*/))
+				Expect(ensureCopies(lom)).To(Equal(numMpaths - 1))
+				Expect(numCopies(lom)).To(Equal(numMpaths))
+			})
+
+			It("should copy under the configured concurrency limit", func() {
+				config := cmn.GCO.BeginUpdate()
+				config.Disk.MaxCopying = 1
+				cmn.GCO.CommitUpdate(config)
+				cluster.SetMaxCopying(config)
+				defer func() {
+					config := cmn.GCO.BeginUpdate()
+					config.Disk.MaxCopying = 0
+					cmn.GCO.CommitUpdate(config)
+					cluster.SetMaxCopying(config)
+				}()
+				lom := prepareLOM(findMpath(testObjectName, bucketLocalE, true /*defaultLoc*/))
+				Expect(ensureCopies(lom)).To(Equal(numMpaths - 1))
+				Expect(numCopies(lom)).To(Equal(numMpaths))
+
+				inflight, _, _ := cluster.CopyStats()
+				Expect(inflight).To(BeZero())
+			})
+
+			Context("per-object override", func() {
+				setMirrorCopies := func(lom *cluster.LOM, n int) {
+					lom.Lock(true)
+					defer lom.Unlock(true)
+					Expect(lom.SetMirrorCopies(n)).NotTo(HaveOccurred())
+					Expect(lom.Persist()).NotTo(HaveOccurred())
+				}
+
+				It("should place more copies than the bucket default", func() {
+					lom := prepareLOM(findMpath("override/higher", bucketLocalC, true /*defaultLoc*/))
+					setMirrorCopies(lom, numMpaths)
+					Expect(ensureCopies(lom)).To(Equal(numMpaths - 1))
+					Expect(numCopies(lom)).To(Equal(numMpaths))
+
+					lom = NewBasicLom(lom.FQN)
+					lom.Uncache(true /*delDirty*/)
+					Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+					Expect(lom.MirrorCopies()).To(BeEquivalentTo(numMpaths))
+					over, _ := lom.IsOverReplicated()
+					Expect(over).To(BeFalse())
+				})
+
+				It("should place fewer copies than the bucket default", func() {
+					lom := prepareLOM(findMpath("override/lower", bucketLocalE, true /*defaultLoc*/))
+					setMirrorCopies(lom, 2)
+					Expect(ensureCopies(lom)).To(Equal(1))
+					Expect(numCopies(lom)).To(Equal(2))
+
+					lom = NewBasicLom(lom.FQN)
+					Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+					Expect(lom.ToMpath()).To(BeNil())
+				})
+
+				It("should fall back to the bucket default when reset", func() {
+					lom := prepareLOM(findMpath("override/reset", bucketLocalC, true /*defaultLoc*/))
+					setMirrorCopies(lom, numMpaths)
+					setMirrorCopies(lom, 0)
+					Expect(lom.MirrorCopies()).To(BeEquivalentTo(2))
+					Expect(ensureCopies(lom)).To(Equal(1))
+					Expect(numCopies(lom)).To(Equal(2))
+				})
+
+				It("should reject invalid values", func() {
+					lom := prepareLOM(findMpath("override/invalid", bucketLocalC, true /*defaultLoc*/))
+					lom.Lock(true)
+					defer lom.Unlock(true)
+					Expect(lom.SetMirrorCopies(-1)).To(HaveOccurred())
+					Expect(lom.SetMirrorCopies(1000)).To(HaveOccurred())
+				})
+			})
+
+			Context("age-based rule", func() {
+				// (loaded atime - in memory and on disk, for the subsequent loads)
+				age := func(lom *cluster.LOM, d time.Duration) {
+					atime := time.Now().Add(-d)
+					lom.Uncache(true /*delDirty*/)
+					Expect(os.Chtimes(lom.FQN, atime, atime)).NotTo(HaveOccurred())
+					lom.SetAtimeUnix(atime.UnixNano())
+				}
+
+				It("should fully replicate young objects", func() {
+					lom := prepareLOM(findMpath("age/young", bucketLocalH, true /*defaultLoc*/))
+					age(lom, time.Hour-time.Minute)
+					Expect(lom.MirrorCopies()).To(BeEquivalentTo(numMpaths))
+					Expect(lom.ToMpath()).NotTo(BeNil())
+					Expect(ensureCopies(lom)).To(Equal(numMpaths - 1))
+					Expect(numCopies(lom)).To(Equal(numMpaths))
+				})
+
+				It("should not replicate cold objects", func() {
+					lom := prepareLOM(findMpath("age/cold", bucketLocalH, true /*defaultLoc*/))
+					age(lom, time.Hour+time.Minute)
+					Expect(lom.MirrorCopies()).To(BeEquivalentTo(1))
+					Expect(lom.ToMpath()).To(BeNil())
+					Expect(ensureCopies(lom)).To(Equal(0))
+					Expect(numCopies(lom)).To(Equal(1))
+				})
+
+				It("should consider aged replicas extra", func() {
+					lom := prepareLOM(findMpath("age/aging", bucketLocalH, true /*defaultLoc*/))
+					Expect(ensureCopies(lom)).To(Equal(numMpaths - 1))
+
+					lom = NewBasicLom(lom.FQN)
+					Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+					over, _ := lom.IsOverReplicated()
+					Expect(over).To(BeFalse())
+
+					age(lom, 2*time.Hour)
+					over, extra := lom.IsOverReplicated()
+					Expect(over).To(BeTrue())
+					Expect(extra).To(Equal(numMpaths - 1))
+				})
+
+				It("should give precedence to per-object override", func() {
+					lom := prepareLOM(findMpath("age/override", bucketLocalH, true /*defaultLoc*/))
+					age(lom, 2*time.Hour)
+					lom.Lock(true)
+					Expect(lom.SetMirrorCopies(2)).NotTo(HaveOccurred())
+					Expect(lom.Persist()).NotTo(HaveOccurred())
+					lom.Unlock(true)
+					Expect(ensureCopies(lom)).To(Equal(1))
+					Expect(numCopies(lom)).To(Equal(2))
+				})
+			})
+		})
+
+		Describe("CopyCountByMpath", func() {
+			It("should count copies as they get created and removed", func() {
+				const numObjs = 6
+				var (
+					loms     []*cluster.LOM
+					before   = fs.CopyCountByMpath()
+					expected = make(map[string]int64, numMpaths)
+					buf      = make([]byte, testFileSize)
+				)
+				check := func() {
+					counts := fs.CopyCountByMpath()
+					for _, mpath := range mpaths {
+						Expect(counts[mpath]-before[mpath]).To(Equal(expected[mpath]), mpath)
+					}
+				}
+
+				// create
+				for i := 0; i < numObjs; i++ {
+					objName := "counts/obj-" + strconv.Itoa(i)
+					lom := NewBasicLom(prepareLOM(findMpath(objName, bucketLocalE, true /*defaultLoc*/)).FQN)
+					created, err := lom.EnsureCopies(buf)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(created).To(Equal(numMpaths - 1))
+					for _, mpath := range mpaths {
+						if mpath != lom.MpathInfo().Path {
+							expected[mpath]++
+						}
+					}
+					loms = append(loms, NewBasicLom(lom.FQN))
+				}
+				check()
+
+				// delete one copy of each of the first half
+				for _, lom := range loms[:numObjs/2] {
+					lom.Lock(true)
+					Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+					for copyFQN, mpi := range lom.GetCopies() {
+						if copyFQN == lom.FQN {
+							continue
+						}
+						mpath := mpi.Path
+						Expect(lom.DelCopies(copyFQN)).NotTo(HaveOccurred())
+						Expect(lom.Persist()).NotTo(HaveOccurred())
+						expected[mpath]--
+						break
+					}
+					lom.Unlock(true)
+				}
+				check()
+
+				// delete all the rest
+				removed, err := cluster.DelAllCopiesBatch(context.Background(), loms)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(removed).To(Equal(numObjs*(numMpaths-1) - numObjs/2))
+				for _, mpath := range mpaths {
+					expected[mpath] = 0
+				}
+				check()
+			})
+		})
+
+		Describe("CopyCountByMpath (bounds)", func() {
+			It("should not count the same copy twice", func() {
+				lom := NewBasicLom(prepareLOM(mirrorFQNs[0]).FQN)
+				mi, _, err := fs.FQN2Mpath(mirrorFQNs[1])
+				Expect(err).NotTo(HaveOccurred())
+				before := fs.CopyCountByMpath()[mi.Path]
+
+				lom.Lock(true)
+				Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+				Expect(lom.Copy(mi, make([]byte, testFileSize))).NotTo(HaveOccurred())
+				Expect(lom.Copy(mi, make([]byte, testFileSize))).NotTo(HaveOccurred()) // (exists and is registered)
+				lom.Unlock(true)
+				Expect(lom.NumCopies()).To(Equal(2))
+				Expect(fs.CopyCountByMpath()[mi.Path]).To(Equal(before + 1))
+			})
+
+			It("should not go below zero", func() {
+				mpath := mpaths[0]
+				n := fs.CopyCountByMpath()[mpath]
+				for i := int64(0); i < n+3; i++ {
+					fs.DecCopies(mpath)
+				}
+				Expect(fs.CopyCountByMpath()[mpath]).To(BeZero())
+			})
+		})
+
+		Describe("FlushCopyMd", func() {
+			versionOnDisk := func(fqn string) string {
+				lom := NewBasicLom(fqn)
+				Expect(lom.FromFS()).NotTo(HaveOccurred())
+				return lom.Version()
+			}
+			// switch the bucket to delayed (metadata) write policy and update the object's version
+			updateDelayed := func(lom *cluster.LOM, version string) (restore func()) {
+				bprops := lom.Bprops()
+				wp := bprops.WritePolicy.MD
+				bprops.WritePolicy.MD = apc.WriteDelayed
+				lom.Lock(true)
+				Expect(lom.Load(true, true)).NotTo(HaveOccurred())
+				lom.SetVersion(version)
+				Expect(lom.Persist()).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				return func() { bprops.WritePolicy.MD = wp }
+			}
+
+			It("should persist deferred metadata on all copies", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				restore := updateDelayed(lom, "2")
+				defer restore()
+				Expect(versionOnDisk(mirrorFQNs[0])).To(Equal(desiredVersion))
+				Expect(versionOnDisk(mirrorFQNs[1])).To(Equal(desiredVersion))
+
+				Expect(NewBasicLom(lom.FQN).FlushCopyMd()).NotTo(HaveOccurred())
+				Expect(versionOnDisk(mirrorFQNs[0])).To(Equal("2"))
+				Expect(versionOnDisk(mirrorFQNs[1])).To(Equal("2"))
+
+				// not dirty anymore
+				lom = NewBasicLom(lom.FQN)
+				Expect(lom.Load(true, false)).NotTo(HaveOccurred())
+				Expect(lom.FlushCopyMd()).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(2))
+			})
+
+			It("should be a no-op when there are no copies", func() {
+				lom := prepareLOM(copyFQNs[0])
+				restore := updateDelayed(lom, "2")
+				defer restore()
+
+				Expect(NewBasicLom(lom.FQN).FlushCopyMd()).NotTo(HaveOccurred())
+				Expect(versionOnDisk(copyFQNs[0])).To(Equal(desiredVersion))
+			})
+		})
+
+		Describe("CompareCopyContent", func() {
+			It("should replace a same-size divergent copy when comparing content", func() {
+				var (
+					hrwFQN  = findMpath(testObjectName, bucketLocalA, true /*defaultLoc*/)
+					copyFQN = findMpath(testObjectName, bucketLocalA, false /*defaultLoc*/)
+					buf     = make([]byte, testFileSize)
+				)
+				lom := prepareLOM(hrwFQN)
+				Expect(lom.Checksum().IsEmpty()).To(BeTrue())
+				// no checksum but enough custom metadata to make two objects metadata-equal
+				lom.SetCustomKey(cmn.ETag, "etag")
+				lom.SetCustomKey(cmn.MD5ObjMD, "md5")
+				Expect(persist(lom)).NotTo(HaveOccurred())
+
+				createTestFile(copyFQN, testFileSize)
+				cplom := NewBasicLom(copyFQN)
+				cplom.CopyAttrs(lom, false /*skip cksum*/)
+				Expect(persist(cplom)).NotTo(HaveOccurred())
+				Expect(cplom.Equal(lom)).To(BeTrue())
+				Expect(getTestFileHash(copyFQN)).NotTo(Equal(getTestFileHash(hrwFQN)))
+
+				parsed, err := fs.ParseFQN(copyFQN)
+				Expect(err).NotTo(HaveOccurred())
+				mi := fs.GetAvail()[parsed.MpathInfo.Path]
+
+				features := cmn.Features
+				defer func() { cmn.Features = features }()
+
+				// default: metadata comparison only - the divergent copy is kept
+				lom.Lock(true)
+				Expect(lom.Copy(mi, buf)).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(getTestFileHash(copyFQN)).NotTo(Equal(getTestFileHash(hrwFQN)))
+
+				// content comparison: the copy gets overwritten
+				cmn.Features = cmn.Features.Set(feat.CompareCopyContent)
+				lom.Lock(true)
+				Expect(lom.Copy(mi, buf)).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(getTestFileHash(copyFQN)).To(Equal(getTestFileHash(hrwFQN)))
+			})
+		})
+
+		Describe("Copy (same size and mtime)", func() {
+			It("should not trust an existing copy whose version differs", func() {
+				var (
+					hrwFQN  = findMpath("fastpath/version", bucketLocalA, true /*defaultLoc*/)
+					copyFQN = findMpath("fastpath/version", bucketLocalA, false /*defaultLoc*/)
+					buf     = make([]byte, testFileSize)
+					mtime   = time.Now().Add(-time.Hour).Add(time.Microsecond)
+				)
+				lom := prepareLOM(hrwFQN)
+				lom.SetVersion("2")
+				Expect(persist(lom)).NotTo(HaveOccurred())
+
+				createTestFile(copyFQN, testFileSize)
+				cplom := NewBasicLom(copyFQN)
+				cplom.CopyAttrs(lom, false /*skip cksum*/)
+				cplom.SetVersion("1")
+				Expect(persist(cplom)).NotTo(HaveOccurred())
+				Expect(getTestFileHash(copyFQN)).NotTo(Equal(getTestFileHash(hrwFQN)))
+				Expect(os.Chtimes(hrwFQN, mtime, mtime)).NotTo(HaveOccurred())
+				Expect(os.Chtimes(copyFQN, mtime, mtime)).NotTo(HaveOccurred())
+
+				parsed, err := fs.ParseFQN(copyFQN)
+				Expect(err).NotTo(HaveOccurred())
+				mi := fs.GetAvail()[parsed.MpathInfo.Path]
+
+				lom.Lock(true)
+				Expect(lom.Copy(mi, buf)).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(getTestFileHash(copyFQN)).To(Equal(getTestFileHash(hrwFQN)))
+			})
+		})
+
+		Describe("VerifyCopiesOnLoad", func() {
+			It("should drop copies that belong to a different object", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				createTestFile(renamedObjFQN, testFileSize)
+
+				lom.Lock(true)
+				Expect(lom.AddCopy(renamedObjFQN, mis[0])).NotTo(HaveOccurred())
+				Expect(persist(lom)).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(3))
+				lom.Unlock(true)
+
+				features := cmn.Features
+				defer func() { cmn.Features = features }()
+
+				// default: loaded as is
+				lom = NewBasicLom(mirrorFQNs[0])
+				lom.Uncache(true /*delDirty*/)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(3))
+
+				// verified: the unrelated FQN is gone
+				cmn.Features = cmn.Features.Set(feat.VerifyCopiesOnLoad)
+				lom = NewBasicLom(mirrorFQNs[0])
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(2))
+				lom.Lock(false)
+				Expect(lom.GetCopies()).To(And(HaveKey(mirrorFQNs[0]), HaveKey(mirrorFQNs[1])))
+				Expect(lom.GetCopies()).NotTo(HaveKey(renamedObjFQN))
+				lom.Unlock(false)
+			})
+		})
+
+		Describe("DelCopies", func() {
+			It("should delete mirrored copy", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+
+				// Check that no copies were added to metadata.
+				lom.Lock(false)
+				defer lom.Unlock(false)
+				Expect(lom.IsCopy()).To(BeFalse())
+				Expect(lom.HasCopies()).To(BeTrue())
+				Expect(lom.NumCopies()).To(Equal(2))
+				Expect(lom.GetCopies()).To(And(HaveKey(mirrorFQNs[0]), HaveKey(mirrorFQNs[1])))
+
+				// Delete copy and check if it's gone.
+				Expect(lom.DelCopies(mirrorFQNs[1])).ToNot(HaveOccurred())
+				Expect(persist(lom)).ToNot(HaveOccurred())
+				Expect(mirrorFQNs[1]).NotTo(BeAnExistingFile())
+
+				// Reload default object and check if the lom was correctly updated.
+				lom = NewBasicLom(mirrorFQNs[0])
+				Expect(lom.Load(false, true)).ToNot(HaveOccurred())
+				Expect(lom.IsCopy()).To(BeFalse())
+				Expect(lom.HasCopies()).To(BeFalse())
+				Expect(lom.NumCopies()).To(Equal(1))
+				Expect(lom.GetCopies()).To(BeNil())
+			})
+
+			It("should delete mirrored copy and update other copies", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				expectedHash := getTestFileHash(lom.FQN)
+
+				// Check that copies were added to metadata.
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.IsCopy()).To(BeFalse())
+				Expect(lom.HasCopies()).To(BeTrue())
+				Expect(lom.NumCopies()).To(Equal(3))
+				Expect(lom.GetCopies()).To(And(HaveKey(mirrorFQNs[0]), HaveKey(mirrorFQNs[1]), HaveKey(mirrorFQNs[2])))
+
+				// Delete copy and check if it's gone.
+				Expect(lom.DelCopies(mirrorFQNs[1])).ToNot(HaveOccurred())
+				Expect(persist(lom)).ToNot(HaveOccurred())
+				Expect(mirrorFQNs[1]).NotTo(BeAnExistingFile())
+
+				// Reload default object and check if the lom was correctly updated.
+				lom = NewBasicLom(mirrorFQNs[0])
+				Expect(lom.Load(false, true)).ToNot(HaveOccurred())
+				Expect(lom.IsCopy()).To(BeFalse())
+				Expect(lom.HasCopies()).To(BeTrue())
+				Expect(lom.NumCopies()).To(Equal(2))
+				Expect(lom.GetCopies()).To(And(HaveKey(mirrorFQNs[0]), HaveKey(mirrorFQNs[2])))
+
+				// Check that left copy was correctly updated.
+				copyLOM := NewBasicLom(mirrorFQNs[2])
+				Expect(copyLOM.Load(false, true)).NotTo(HaveOccurred())
+				_, cksumValue := copyLOM.Checksum().Get()
+				Expect(cksumValue).To(Equal(expectedHash))
+				Expect(copyLOM.Version()).To(Equal(desiredVersion))
+				Expect(copyLOM.SizeBytes()).To(BeEquivalentTo(testFileSize))
+
+				Expect(copyLOM.IsCopy()).To(BeTrue())
+				Expect(copyLOM.HasCopies()).To(BeTrue())
+				Expect(copyLOM.NumCopies()).To(Equal(lom.NumCopies()))
+				Expect(copyLOM.GetCopies()).To(Equal(lom.GetCopies()))
+			})
+		})
+
+		Describe("orphaned copies", func() {
+			busy := errors.New("injected: file busy")
+			BeforeEach(func() { _ = cluster.TakeOrphans() })
+			AfterEach(func() { cluster.SetRemoveFault(nil) })
+
+			failRemove := func(fqn string) {
+				cluster.SetRemoveFault(func(f string) error {
+					if f == fqn {
+						return busy
+					}
+					return nil
+				})
+			}
+
+			It("should queue copy that failed to be removed and reclaim it later", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+
+				failRemove(mirrorFQNs[1])
+				lom.Lock(true)
+				Expect(lom.DelCopies(mirrorFQNs[1], mirrorFQNs[2])).NotTo(HaveOccurred())
+				Expect(persist(lom)).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(lom.NumCopies()).To(Equal(1))
+				Expect(mirrorFQNs[1]).To(BeARegularFile())
+				Expect(mirrorFQNs[2]).NotTo(BeAnExistingFile())
+
+				queued, _ := cluster.NumOrphans()
+				Expect(queued).To(Equal(1))
+				orphans := cluster.TakeOrphans()
+				Expect(orphans).To(HaveLen(1))
+				Expect(orphans[0].FQN).To(Equal(mirrorFQNs[1]))
+
+				// still busy: stays queued
+				size, _ := cluster.ReclaimOrphan(orphans[0])
+				Expect(size).To(BeZero())
+				Expect(mirrorFQNs[1]).To(BeARegularFile())
+				orphans = cluster.TakeOrphans()
+				Expect(orphans).To(HaveLen(1))
+
+				cluster.SetRemoveFault(nil)
+				size, requeue := cluster.ReclaimOrphan(orphans[0])
+				Expect(requeue).To(BeFalse())
+				Expect(size).To(BeEquivalentTo(testFileSize))
+				Expect(mirrorFQNs[1]).NotTo(BeAnExistingFile())
+				Expect(cluster.TakeOrphans()).To(BeEmpty())
+			})
+
+			It("should queue copies that failed to be removed in batch", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+				mi, _, err := fs.FQN2Mpath(mirrorFQNs[1])
+				Expect(err).NotTo(HaveOccurred())
+				before := fs.CopyCountByMpath()[mi.Path]
+
+				failRemove(mirrorFQNs[1])
+				removed, err := cluster.DelAllCopiesBatch(context.Background(), []*cluster.LOM{NewBasicLom(lom.FQN)})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(removed).To(Equal(1))
+				Expect(mirrorFQNs[1]).To(BeARegularFile())
+				Expect(mirrorFQNs[2]).NotTo(BeAnExistingFile())
+				Expect(fs.CopyCountByMpath()[mi.Path]).To(Equal(before - 1))
+
+				lom = NewBasicLom(lom.FQN)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(1))
+
+				orphans := cluster.TakeOrphans()
+				Expect(orphans).To(HaveLen(1))
+				Expect(orphans[0].FQN).To(Equal(mirrorFQNs[1]))
+			})
+
+			It("should queue extra copy that failed to be removed", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				detached, err := lom.DetachCopies(mirrorFQNs[1])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(detached).To(ConsistOf(mirrorFQNs[1]))
+				Expect(persist(lom)).NotTo(HaveOccurred())
+
+				failRemove(mirrorFQNs[1])
+				_, err = lom.DelExtraCopies()
+				Expect(err).To(MatchError(busy))
+				orphans := cluster.TakeOrphans()
+				Expect(orphans).To(HaveLen(1))
+				Expect(orphans[0].FQN).To(Equal(mirrorFQNs[1]))
+			})
+
+			It("should not reclaim orphan that is a registered copy again", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+
+				failRemove(mirrorFQNs[1])
+				lom.Lock(true)
+				Expect(lom.DelCopies(mirrorFQNs[1])).NotTo(HaveOccurred())
+				Expect(persist(lom)).NotTo(HaveOccurred())
+				cluster.SetRemoveFault(nil)
+
+				// re-copy (keeping the identical orphan in place)
+				parsed, err := fs.ParseFQN(mirrorFQNs[1])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lom.Copy(parsed.MpathInfo, make([]byte, testFileSize))).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(lom.GetCopies()).To(HaveKey(mirrorFQNs[1]))
+
+				orphans := cluster.TakeOrphans()
+				Expect(orphans).To(HaveLen(1))
+				size, requeue := cluster.ReclaimOrphan(orphans[0])
+				Expect(size).To(BeZero())
+				Expect(requeue).To(BeFalse())
+				Expect(mirrorFQNs[1]).To(BeARegularFile())
+			})
+		})
+
+		Describe("DetachCopies", func() {
+			It("should detach copies while keeping the files", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.NumCopies()).To(Equal(3))
+
+				detached, err := lom.DetachCopies(mirrorFQNs[1])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(detached).To(ConsistOf(mirrorFQNs[1]))
+				Expect(persist(lom)).ToNot(HaveOccurred())
+				Expect(mirrorFQNs[1]).To(BeAnExistingFile())
+				Expect(getTestFileHash(mirrorFQNs[1])).To(Equal(getTestFileHash(lom.FQN)))
+
+				// the object and the remaining copy no longer know about the detached one
+				lom = NewBasicLom(mirrorFQNs[0])
+				Expect(lom.Load(false, true)).ToNot(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(2))
+				Expect(lom.GetCopies()).To(And(HaveKey(mirrorFQNs[0]), HaveKey(mirrorFQNs[2])))
+				Expect(lom.GetCopies()).NotTo(HaveKey(mirrorFQNs[1]))
+				copyLOM := NewBasicLom(mirrorFQNs[2])
+				Expect(copyLOM.Load(false, true)).NotTo(HaveOccurred())
+				Expect(copyLOM.GetCopies()).To(Equal(lom.GetCopies()))
+			})
+
+			It("should delete the files in default mode", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				_ = prepareCopy(lom, mirrorFQNs[2])
+
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.DelCopies(mirrorFQNs[1], mirrorFQNs[2])).ToNot(HaveOccurred())
+				Expect(persist(lom)).ToNot(HaveOccurred())
+				Expect(mirrorFQNs[1]).NotTo(BeAnExistingFile())
+				Expect(mirrorFQNs[2]).NotTo(BeAnExistingFile())
+				Expect(lom.NumCopies()).To(Equal(1))
+			})
+
+			It("should fail to detach a non-existing copy", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				detached, err := lom.DetachCopies(mirrorFQNs[2])
+				Expect(err).To(HaveOccurred())
+				Expect(detached).To(BeEmpty())
+				Expect(mirrorFQNs[1]).To(BeAnExistingFile())
+				Expect(lom.NumCopies()).To(Equal(2))
 			})
 		})
 
@@ -58,8 +58,14 @@ const (
 	lomObjSize
 	lomObjCopies
 	lomCustomMD
+	lomObjNumCopies // (cmn.MetaverLOM v2 only)
+	lomPinnedCopies // ditto
 )
 
+// the original layout version - without lomObjNumCopies and lomPinnedCopies records;
+// still written when the object has neither, for older versions to be able to read it
+const metaverLOMv1 = 1
+
 // packing format separators
 const (
 	copyFQNSepa  = "\x00"
@@ -68,7 +74,7 @@ const (
 	lenRecSepa   = len(recordSepa)
 )
 
-const prefLen = 10 // 10B prefix [ version = 1 | checksum-type | 64-bit xxhash ]
+const prefLen = 10 // 10B prefix [ version | checksum-type | 64-bit xxhash ]
 
 const getxattr = "getxattr" // syscall
 
@@ -301,13 +307,15 @@ func (md *lmeta) unmarshal(buf []byte) error {
 		cksumType, cksumValue             string
 		haveSize, haveVersion, haveCopies bool
 		haveCksumType, haveCksumValue     bool
+		haveNumCopies, havePinned         bool
 		last                              bool
 	)
 	if len(buf) < prefLen {
 		return fmt.Errorf("%s: too short (%d)", invalid, len(buf))
 	}
-	if buf[0] != cmn.MetaverLOM {
-		return fmt.Errorf("%s: unknown version %d", invalid, buf[0])
+	ver := buf[0]
+	if ver != cmn.MetaverLOM && ver != metaverLOMv1 {
+		return fmt.Errorf("%s: unknown version %d", invalid, ver)
 	}
 	if buf[1] != mdCksumTyXXHash {
 		return fmt.Errorf("%s: unknown checksum %d", invalid, buf[1])
@@ -319,6 +327,7 @@ func (md *lmeta) unmarshal(buf []byte) error {
 		return cos.NewBadMetaCksumError(expectedCksum, actualCksum, md.String())
 	}
 
+	md.ncopies, md.pinned = 0, nil
 	for off := 0; !last; {
 		var (
 			record string
@@ -391,6 +400,25 @@ func (md *lmeta) unmarshal(buf []byte) error {
 				custom[entries[i]] = entries[i+1]
 			}
 			md.SetCustomMD(custom)
+		case lomObjNumCopies:
+			if haveNumCopies || len(val) != cos.SizeofI16 || ver == metaverLOMv1 {
+				return errors.New(invalid + " #5.2")
+			}
+			md.ncopies = int16(binary.BigEndian.Uint16([]byte(val)))
+			haveNumCopies = true
+		case lomPinnedCopies:
+			if havePinned || ver == metaverLOMv1 {
+				return errors.New(invalid + " #5.3")
+			}
+			havePinned = true
+			pinned := strings.Split(val, copyFQNSepa)
+			md.pinned = make(cos.StrSet, len(pinned))
+			for _, copyFQN := range pinned {
+				if copyFQN == "" {
+					return errors.New(invalid + " #5.4")
+				}
+				md.pinned.Add(copyFQN)
+			}
 		default:
 			return errors.New(invalid + " #6")
 		}
@@ -431,9 +459,23 @@ func (md *lmeta) marshal(mm *memsys.MMSA, mdSize int64) (buf []byte) {
 		buf = _marshRecord(mm, buf, lomCustomMD, "", false)
 		buf = _marshCustomMD(mm, buf, custom)
 	}
+	if md.ncopies > 0 {
+		var b2 [cos.SizeofI16]byte
+		binary.BigEndian.PutUint16(b2[:], uint16(md.ncopies))
+		buf = mm.Append(buf, recordSepa)
+		buf = _marshRecord(mm, buf, lomObjNumCopies, string(b2[:]), false)
+	}
+	if len(md.pinned) > 0 {
+		buf = mm.Append(buf, recordSepa)
+		buf = _marshRecord(mm, buf, lomPinnedCopies, "", false)
+		buf = _marshPinned(mm, buf, md.pinned)
+	}
 
 	// checksum, prepend, and return
 	buf[0] = cmn.MetaverLOM
+	if md.ncopies <= 0 && len(md.pinned) == 0 {
+		buf[0] = metaverLOMv1
+	}
 	buf[1] = mdCksumTyXXHash
 	mdCksumValue := xxhash.Checksum64S(buf[prefLen:], cos.MLCG32)
 	binary.BigEndian.PutUint64(buf[2:], mdCksumValue)
@@ -467,6 +509,21 @@ func _marshCopies(mm *memsys.MMSA, buf []byte, copies fs.MPI) []byte {
 	return buf
 }
 
+func _marshPinned(mm *memsys.MMSA, buf []byte, pinned cos.StrSet) []byte {
+	var (
+		i   int
+		num = len(pinned)
+	)
+	for copyFQN := range pinned {
+		i++
+		buf = mm.Append(buf, copyFQN)
+		if i < num {
+			buf = mm.Append(buf, copyFQNSepa)
+		}
+	}
+	return buf
+}
+
 func _marshCustomMD(mm *memsys.MMSA, buf []byte, md cos.StrKVs) []byte {
 	var (
 		i   int
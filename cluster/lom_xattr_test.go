@@ -337,6 +337,26 @@ var _ = Describe("LOM Xattributes", func() {
 					Expect(err).To(MatchError("invalid lmeta: unknown version 0"))
 				})
 
+				It("should write v2 only when needed and reject v2 records in v1", func() {
+					b, err := fs.GetXattr(localFQN, cluster.XattrLOM)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(b[0]).To(BeEquivalentTo(1)) // (no per-object copies, no pins)
+
+					lom.Lock(true)
+					Expect(lom.SetMirrorCopies(3)).NotTo(HaveOccurred())
+					Expect(persist(lom)).NotTo(HaveOccurred())
+					lom.Unlock(true)
+					b, err = fs.GetXattr(localFQN, cluster.XattrLOM)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(b[0]).To(BeEquivalentTo(cmn.MetaverLOM))
+					Expect(lom.LoadMetaFromFS()).NotTo(HaveOccurred())
+					Expect(lom.MirrorCopies()).To(BeEquivalentTo(3))
+
+					b[0] = 1
+					Expect(fs.SetXattr(localFQN, cluster.XattrLOM, b)).NotTo(HaveOccurred())
+					Expect(lom.LoadMetaFromFS()).To(HaveOccurred())
+				})
+
 				It("should fail when metadata is too short", func() {
 					Expect(fs.SetXattr(localFQN, cluster.XattrLOM, []byte{1})).NotTo(HaveOccurred())
 					err := lom.LoadMetaFromFS()
@@ -0,0 +1,113 @@
+// Package cluster provides common interfaces and local access to cluster-level metadata
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cluster
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/atomic"
+)
+
+//
+// orphaned copies: dropped from the object's metadata (see DelCopies, DelExtraCopies)
+// but failed to be physically removed - e.g., a transiently busy file.
+// Orphans are queued for space cleanup to reclaim later (see ReclaimOrphan);
+// the queue is bounded and does not survive restarts - beyond that, it is
+// the cleanup's full walk that eventually takes care of the leftovers.
+//
+
+const maxOrphans = 1024
+
+type Orphan struct {
+	FQN    string
+	Queued int64 // unix nano
+}
+
+var (
+	orphans struct {
+		mu    sync.Mutex
+		queue []Orphan
+	}
+	orphansDropped atomic.Int64
+)
+
+// removes a copy that is no longer (or never was) in the object's metadata
+// and queues it upon failure
+func rmOrphan(fqn string) (err error) {
+	if err = fops.remove(fqn); err != nil {
+		QueueOrphan(fqn)
+	}
+	return
+}
+
+// QueueOrphan returns false when the queue is full (in which case the orphan gets
+// dropped and counted - see NumOrphans)
+func QueueOrphan(fqn string) bool {
+	orphans.mu.Lock()
+	if len(orphans.queue) >= maxOrphans {
+		orphans.mu.Unlock()
+		orphansDropped.Inc()
+		return false
+	}
+	orphans.queue = append(orphans.queue, Orphan{FQN: fqn, Queued: time.Now().UnixNano()})
+	orphans.mu.Unlock()
+	return true
+}
+
+// TakeOrphans returns all currently queued orphans and empties the queue
+func TakeOrphans() (taken []Orphan) {
+	orphans.mu.Lock()
+	taken = orphans.queue
+	orphans.queue = nil
+	orphans.mu.Unlock()
+	return
+}
+
+func NumOrphans() (queued int, dropped int64) {
+	orphans.mu.Lock()
+	queued = len(orphans.queue)
+	orphans.mu.Unlock()
+	return queued, orphansDropped.Load()
+}
+
+// ReclaimOrphan removes the orphan unless:
+// - it no longer exists or has been modified since queued (nothing to do, not ours anymore);
+// - it is now the object itself or its registered copy (ditto);
+// - the object is busy, or the removal fails again (requeue = true).
+// Returns the number of removed bytes.
+func ReclaimOrphan(orphan Orphan) (size int64, requeue bool) {
+	finfo, err := os.Stat(orphan.FQN)
+	if err != nil || finfo.ModTime().UnixNano() > orphan.Queued {
+		return
+	}
+	lom := AllocLOM("")
+	defer FreeLOM(lom)
+	if err := lom.InitFQN(orphan.FQN, nil); err != nil {
+		return // (e.g., bucket is gone)
+	}
+	hlom := AllocLOM(lom.ObjName)
+	defer FreeLOM(hlom)
+	if err := hlom.InitBck(lom.Bucket()); err != nil {
+		return
+	}
+	if hlom.FQN == orphan.FQN {
+		return
+	}
+	if !hlom.TryLock(true) {
+		return 0, true
+	}
+	defer hlom.Unlock(true)
+	if err := hlom.Load(false /*cache it*/, true /*locked*/); err == nil {
+		if _, ok := hlom.GetCopies()[orphan.FQN]; ok {
+			return
+		}
+	}
+	if err := rmOrphan(orphan.FQN); err != nil {
+		return // (requeued by rmOrphan)
+	}
+	return finfo.Size(), false
+}
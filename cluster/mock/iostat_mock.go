@@ -18,6 +18,7 @@ type (
 func NewIOStater() *IOStater                                   { return &IOStater{} }
 func (m *IOStater) GetAllMpathUtils() *ios.MpathUtil           { return &m.Utils }
 func (m *IOStater) GetMpathUtil(mpath string) int64            { return m.Utils.Get(mpath) }
+func (*IOStater) GetMpathWbps(string) int64                    { return 0 }
 func (*IOStater) AddMpath(string, string) (ios.FsDisks, error) { return nil, nil }
 func (*IOStater) RemoveMpath(string)                           {}
 func (*IOStater) LogAppend(l []string) []string                { return l }
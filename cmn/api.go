@@ -117,13 +117,45 @@ type (
 		Fast       bool   `json:"fast"`
 		ObjCached  bool   `json:"cached"`
 		BckPresent bool   `json:"present"`
+		// optionally, count (present) objects by size; the histogram is defined by
+		// its ascending (exclusive) upper bounds - see DfltSizeHistBounds
+		SizeHist   bool    `json:"size_hist,omitempty"`
+		HistBounds []int64 `json:"hist_bounds,omitempty"`
+		// optionally, summarize only the objects with names starting with this prefix
+		// (always walks the matching objects - `Fast` is ignored - and reports their
+		// total size in place of the bucket's on-disk size)
+		Prefix string `json:"prefix,omitempty"`
+		// optionally, count (present) objects that meet, fall below, or exceed their
+		// replication target (see BsummRepl); is costly - checks each copy on disk - and
+		// always walks the objects (`Fast` is ignored)
+		ReplHealth bool `json:"repl_health,omitempty"`
+	}
+	// object count distribution by size: Counts[i] is the number of objects
+	// with size in [Bounds[i-1], Bounds[i]); the last count is for sizes >= max(Bounds)
+	BsummSizeHist struct {
+		Bounds []int64  `json:"bounds"`
+		Counts []uint64 `json:"counts"`
+	}
+	// replication health: number of objects that have (on disk) exactly the number of copies
+	// they are supposed to have, fewer, and more (see cluster.LOM.ExpectedCopies)
+	BsummRepl struct {
+		Met   uint64 `json:"met,string"`
+		Below uint64 `json:"below,string"`
+		Above uint64 `json:"above,string"`
+	}
+	// number and total size of the objects known to exist in the remote backend
+	BsummTally struct {
+		ObjCount uint64 `json:"obj_count,string"`
+		Size     uint64 `json:"size,string"`
 	}
 	// "summarized" result for a given bucket
 	BsummResult struct {
 		Bck
+		// local tally (ObjCount.Present, TotalSize.PresentObjs): objects present (cached)
+		// in the cluster, aggregated across all targets
 		ObjCount struct {
 			Present uint64 `json:"obj_count_present,string"`
-			Remote  uint64 `json:"obj_count_remote,string"`
+			Remote  uint64 `json:"obj_count_remote,string"` // (same as Remote.ObjCount - older clients)
 		}
 		ObjSize struct {
 			Min int64 `json:"obj_min_size"`
@@ -133,11 +165,16 @@ type (
 		TotalSize struct {
 			OnDisk      uint64 `json:"size_on_disk,string"`          // sum(dir sizes) aka "apparent size"
 			PresentObjs uint64 `json:"size_all_present_objs,string"` // sum(cached object sizes)
-			RemoteObjs  uint64 `json:"size_all_remote_objs,string"`  // sum(all object sizes in a remote bucket)
+			RemoteObjs  uint64 `json:"size_all_remote_objs,string"`  // (same as Remote.Size - older clients)
 			Disks       uint64 `json:"total_disks_size,string"`
 		}
-		UsedPct      uint64 `json:"used_pct"`
-		IsBckPresent bool   `json:"is_present"` // in BMD
+		// remote tally: listed via the backend by a single target, and only when
+		// requested (i.e., when BsummCtrlMsg.ObjCached is false); nil otherwise
+		Remote       *BsummTally    `json:"remote,omitempty"`
+		SizeHist     *BsummSizeHist `json:"size_hist,omitempty"`   // (when requested via BsummCtrlMsg.SizeHist)
+		Repl         *BsummRepl     `json:"replication,omitempty"` // (when requested via BsummCtrlMsg.ReplHealth)
+		UsedPct      uint64         `json:"used_pct"`
+		IsBckPresent bool           `json:"is_present"` // in BMD
 	}
 	AllBsummResults []*BsummResult
 )
@@ -328,6 +365,55 @@ func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 // interface guard
 var _ sort.Interface = (*AllBsummResults)(nil)
 
+// default size-histogram buckets: <4K, 4K-64K, 64K-1M, >=1M
+var DfltSizeHistBounds = []int64{4 * cos.KiB, 64 * cos.KiB, cos.MiB}
+
+func (msg *BsummCtrlMsg) Validate() error {
+	if !msg.SizeHist {
+		if len(msg.HistBounds) > 0 {
+			return fmt.Errorf("bucket summary: histogram bounds %v specified without requesting size histogram",
+				msg.HistBounds)
+		}
+		return nil
+	}
+	if len(msg.HistBounds) == 0 {
+		msg.HistBounds = DfltSizeHistBounds
+		return nil
+	}
+	for i, b := range msg.HistBounds {
+		if b <= 0 || (i > 0 && b <= msg.HistBounds[i-1]) {
+			return fmt.Errorf("bucket summary: invalid histogram bounds %v (expecting positive ascending sizes)",
+				msg.HistBounds)
+		}
+	}
+	return nil
+}
+
+func NewBsummSizeHist(bounds []int64) *BsummSizeHist {
+	return &BsummSizeHist{Bounds: bounds, Counts: make([]uint64, len(bounds)+1)}
+}
+
+func (h *BsummSizeHist) Add(size int64) {
+	i := sort.Search(len(h.Bounds), func(i int) bool { return size < h.Bounds[i] })
+	h.Counts[i]++
+}
+
+func (h *BsummSizeHist) merge(from *BsummSizeHist) {
+	debug.Assert(len(h.Counts) == len(from.Counts), len(h.Counts), " vs ", len(from.Counts))
+	for i := range from.Counts {
+		h.Counts[i] += from.Counts[i]
+	}
+}
+
+// fraction of the objects that meet their replication target (1 when there are no objects)
+func (r *BsummRepl) MetRatio() float64 {
+	total := r.Met + r.Below + r.Above
+	if total == 0 {
+		return 1
+	}
+	return float64(r.Met) / float64(total)
+}
+
 func NewBsummResult(bck *Bck, totalDisksSize uint64) (bs *BsummResult) {
 	bs = &BsummResult{Bck: *bck}
 	bs.TotalSize.Disks = totalDisksSize
@@ -362,6 +448,24 @@ func aggr(from, to *BsummResult) {
 	to.TotalSize.OnDisk += from.TotalSize.OnDisk
 	to.TotalSize.PresentObjs += from.TotalSize.PresentObjs
 	to.TotalSize.RemoteObjs += from.TotalSize.RemoteObjs
+	if from.Remote != nil {
+		debug.Assert(to.Remote == nil, "remote tally is expected to be computed once: ", to.Bck.String())
+		to.Remote = from.Remote
+	}
+	if from.SizeHist != nil {
+		if to.SizeHist == nil {
+			to.SizeHist = NewBsummSizeHist(from.SizeHist.Bounds)
+		}
+		to.SizeHist.merge(from.SizeHist)
+	}
+	if from.Repl != nil {
+		if to.Repl == nil {
+			to.Repl = &BsummRepl{}
+		}
+		to.Repl.Met += from.Repl.Met
+		to.Repl.Below += from.Repl.Below
+		to.Repl.Above += from.Repl.Above
+	}
 }
 
 func (s AllBsummResults) Finalize(dsize map[string]uint64, testingEnv bool) {
@@ -160,14 +160,32 @@ type (
 	BackendConfAIS map[string][]string // cluster alias -> [urls...]
 
 	MirrorConf struct {
-		Copies  int64 `json:"copies"`       // num copies
-		Burst   int   `json:"burst_buffer"` // xaction channel (buffer) size
-		Enabled bool  `json:"enabled"`      // enabled (to generate copies)
+		// mountpaths allowed to store copies (empty - any); note that the "main" replica
+		// is always HRW-located (see lom.ToMpath)
+		Mpaths    []string `json:"mpaths,omitempty"`
+		Copies    int64    `json:"copies"`       // num copies
+		Burst     int      `json:"burst_buffer"` // xaction channel (buffer) size
+		Enabled   bool     `json:"enabled"`      // enabled (to generate copies)
+		HealOnGet bool     `json:"heal_on_get"`  // GET: asynchronously add missing copies (see lom.LBGet)
+		LazyCksum bool     `json:"lazy_cksum"`   // copy without checksumming and verify later, in background
+		// age-based rule (none when zero): objects not accessed for at least `AgeLimit`
+		// get `ColdCopies` instead of `Copies` (see lom.MirrorCopies)
+		AgeLimit   cos.Duration `json:"age_limit,omitempty"`
+		ColdCopies int64        `json:"cold_copies,omitempty"`
+		// GET: always read the object at its default (HRW) location - no load balancing across copies
+		DisableLBGet bool `json:"disable_lb_get,omitempty"`
 	}
 	MirrorConfToUpdate struct {
-		Copies  *int64 `json:"copies,omitempty"`
-		Burst   *int   `json:"burst_buffer,omitempty"`
-		Enabled *bool  `json:"enabled,omitempty"`
+		Mpaths     *[]string     `json:"mpaths,omitempty"`
+		Copies     *int64        `json:"copies,omitempty"`
+		Burst      *int          `json:"burst_buffer,omitempty"`
+		Enabled    *bool         `json:"enabled,omitempty"`
+		HealOnGet  *bool         `json:"heal_on_get,omitempty"`
+		LazyCksum  *bool         `json:"lazy_cksum,omitempty"`
+		AgeLimit   *cos.Duration `json:"age_limit,omitempty"`
+		ColdCopies *int64        `json:"cold_copies,omitempty"`
+		// (see MirrorConf.DisableLBGet)
+		DisableLBGet *bool `json:"disable_lb_get,omitempty"`
 	}
 
 	ECConf struct {
@@ -275,12 +293,18 @@ type (
 		// Out-of-Space: if exceeded, the target starts failing new PUTs and keeps
 		// failing them until its local used-cap gets back below HighWM (see above)
 		OOS int64 `json:"out_of_space"`
+
+		// MirrorMinFree: free capacity watermark (% of a given mountpath's capacity) - mountpaths
+		// below it are not considered for placing new copies unless all of them are;
+		// zero (default) disables the check
+		MirrorMinFree int64 `json:"mirror_min_free"`
 	}
 	SpaceConfToUpdate struct {
-		CleanupWM *int64 `json:"cleanupwm,omitempty"`
-		LowWM     *int64 `json:"lowwm,omitempty"`
-		HighWM    *int64 `json:"highwm,omitempty"`
-		OOS       *int64 `json:"out_of_space,omitempty"`
+		CleanupWM     *int64 `json:"cleanupwm,omitempty"`
+		LowWM         *int64 `json:"lowwm,omitempty"`
+		HighWM        *int64 `json:"highwm,omitempty"`
+		OOS           *int64 `json:"out_of_space,omitempty"`
+		MirrorMinFree *int64 `json:"mirror_min_free,omitempty"`
 	}
 
 	LRUConf struct {
@@ -306,6 +330,11 @@ type (
 		DiskUtilMaxWM   int64        `json:"disk_util_max_wm"`
 		IostatTimeLong  cos.Duration `json:"iostat_time_long"`
 		IostatTimeShort cos.Duration `json:"iostat_time_short"`
+
+		// MaxCopying: max number of concurrent local (disk-to-disk) object copies per target,
+		// regardless of the caller (mirroring, restore, copy-on-read, etc.);
+		// zero (default) translates as 4 x (number of available mountpaths)
+		MaxCopying int64 `json:"max_copying"`
 	}
 	DiskConfToUpdate struct {
 		DiskUtilLowWM   *int64        `json:"disk_util_low_wm,omitempty"`
@@ -313,6 +342,7 @@ type (
 		DiskUtilMaxWM   *int64        `json:"disk_util_max_wm,omitempty"`
 		IostatTimeLong  *cos.Duration `json:"iostat_time_long,omitempty"`
 		IostatTimeShort *cos.Duration `json:"iostat_time_short,omitempty"`
+		MaxCopying      *int64        `json:"max_copying,omitempty"`
 	}
 
 	RebalanceConf struct {
@@ -492,6 +522,12 @@ type (
 
 	FSPConf struct {
 		Paths cos.StrSet `json:"paths,omitempty" list:"readonly"`
+		// optional failure-domain labels: mountpath => domain (e.g., disk enclosure);
+		// configured as `"fspaths": {"/ais/mp1": {"domain": "encl-a"}, ...}` - see fs.LeastUtilNoCopy
+		Domains cos.StrKVs `json:"-"`
+	}
+	fspathJSON struct {
+		Domain string `json:"domain,omitempty"`
 	}
 
 	TransportConf struct {
@@ -507,6 +543,9 @@ type (
 		// fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
 		LZ4BlockMaxSize  cos.Size `json:"lz4_block"`
 		LZ4FrameChecksum bool     `json:"lz4_frame_checksum"`
+		// max number of concurrently active receive streams per network (across all trnames); when
+		// exceeded, new streams get rejected with 503 (retryable); zero (default) means unlimited
+		MaxRxStreams int `json:"max_rx_streams"`
 	}
 	TransportConfToUpdate struct {
 		MaxHeaderSize    *int          `json:"max_header,omitempty" list:"readonly"`
@@ -515,6 +554,7 @@ type (
 		QuiesceTime      *cos.Duration `json:"quiescent,omitempty"`
 		LZ4BlockMaxSize  *cos.Size     `json:"lz4_block,omitempty"`
 		LZ4FrameChecksum *bool         `json:"lz4_frame_checksum,omitempty"`
+		MaxRxStreams     *int          `json:"max_rx_streams,omitempty"`
 	}
 
 	MemsysConf struct {
@@ -721,6 +761,7 @@ func (c *LocalConfig) AddPath(mpath string) {
 func (c *LocalConfig) DelPath(mpath string) {
 	debug.Assert(!c.TestingEnv())
 	c.FSP.Paths.Delete(mpath)
+	delete(c.FSP.Domains, mpath)
 }
 
 ////////////////
@@ -956,6 +997,9 @@ func (c *DiskConf) Validate() (err error) {
 		return fmt.Errorf("disk.iostat_time_long %v shorter than disk.iostat_time_short %v",
 			c.IostatTimeLong, c.IostatTimeShort)
 	}
+	if c.MaxCopying < 0 {
+		return fmt.Errorf("invalid disk.max_copying %d (expecting non-negative)", c.MaxCopying)
+	}
 	return nil
 }
 
@@ -966,6 +1010,8 @@ func (c *DiskConf) Validate() (err error) {
 func (c *SpaceConf) Validate() (err error) {
 	if c.CleanupWM <= 0 || c.LowWM < c.CleanupWM || c.HighWM < c.LowWM || c.OOS < c.HighWM || c.OOS > 100 {
 		err = fmt.Errorf("invalid %s (expecting: 0 < cleanup < low < high < OOS < 100)", c)
+	} else if c.MirrorMinFree < 0 || c.MirrorMinFree >= 100 {
+		err = fmt.Errorf("invalid %s (expecting: 0 <= mirror_min_free < 100)", c)
 	}
 	return
 }
@@ -973,8 +1019,8 @@ func (c *SpaceConf) Validate() (err error) {
 func (c *SpaceConf) ValidateAsProps(...any) error { return c.Validate() }
 
 func (c *SpaceConf) String() string {
-	return fmt.Sprintf("space config: cleanup=%d%%, low=%d%%, high=%d%%, OOS=%d%%",
-		c.CleanupWM, c.LowWM, c.HighWM, c.OOS)
+	return fmt.Sprintf("space config: cleanup=%d%%, low=%d%%, high=%d%%, OOS=%d%%, mirror-min-free=%d%%",
+		c.CleanupWM, c.LowWM, c.HighWM, c.OOS, c.MirrorMinFree)
 }
 
 /////////////
@@ -1068,9 +1114,25 @@ func (c *MirrorConf) Validate() error {
 	if c.Copies < 2 || c.Copies > 32 {
 		return fmt.Errorf("invalid mirror.copies: %d (expected value in range [2, 32])", c.Copies)
 	}
+	if c.AgeLimit < 0 {
+		return fmt.Errorf("invalid mirror.age_limit: %v (expected >=0)", c.AgeLimit)
+	}
+	if c.AgeLimit > 0 && (c.ColdCopies < 1 || c.ColdCopies > 32) {
+		return fmt.Errorf("invalid mirror.cold_copies: %d (expected value in range [1, 32])", c.ColdCopies)
+	}
+	for _, mpath := range c.Mpaths {
+		if !filepath.IsAbs(mpath) || filepath.Clean(mpath) != mpath {
+			return fmt.Errorf("invalid mirror.mpaths: %q (expecting absolute clean path)", mpath)
+		}
+	}
 	return nil
 }
 
+// returns true if copies are allowed to be stored at a given mountpath
+func (c *MirrorConf) MpathAllowed(mpath string) bool {
+	return len(c.Mpaths) == 0 || cos.StringInSlice(mpath, c.Mpaths)
+}
+
 func (c *MirrorConf) ValidateAsProps(...any) error {
 	if !c.Enabled {
 		return nil
@@ -1083,7 +1145,14 @@ func (c *MirrorConf) String() string {
 		return "Disabled"
 	}
 
-	return fmt.Sprintf("%d copies", c.Copies)
+	s := fmt.Sprintf("%d copies", c.Copies)
+	if c.AgeLimit > 0 {
+		s += fmt.Sprintf(" (%d after %v)", c.ColdCopies, c.AgeLimit)
+	}
+	if len(c.Mpaths) > 0 {
+		s += fmt.Sprintf(" (mountpaths: %v)", c.Mpaths)
+	}
+	return s
 }
 
 ////////////
@@ -1333,17 +1402,30 @@ func (c *DSortConf) ValidateWithOpts(allowEmpty bool) (err error) {
 /////////////
 
 func (c *FSPConf) UnmarshalJSON(data []byte) (err error) {
-	m := cos.NewStrSet()
+	m := make(map[string]fspathJSON, 4)
 	err = jsoniter.Unmarshal(data, &m)
 	if err != nil {
 		return
 	}
-	c.Paths = m
+	c.Paths, c.Domains = make(cos.StrSet, len(m)), nil
+	for fspath, v := range m {
+		c.Paths.Set(fspath)
+		if v.Domain != "" {
+			if c.Domains == nil {
+				c.Domains = make(cos.StrKVs, len(m))
+			}
+			c.Domains[fspath] = v.Domain
+		}
+	}
 	return
 }
 
 func (c *FSPConf) MarshalJSON() (data []byte, err error) {
-	return cos.MustMarshal(c.Paths), nil
+	m := make(map[string]fspathJSON, len(c.Paths))
+	for fspath := range c.Paths {
+		m[fspath] = fspathJSON{Domain: c.Domains[fspath]}
+	}
+	return cos.MustMarshal(m), nil
 }
 
 func (c *FSPConf) Validate(contextConfig *Config) error {
@@ -1358,12 +1440,21 @@ func (c *FSPConf) Validate(contextConfig *Config) error {
 		return NewErrInvalidFSPathsConf(ErrNoMountpaths)
 	}
 
-	cleanMpaths := make(map[string]struct{})
+	var (
+		cleanMpaths  = make(map[string]struct{})
+		cleanDomains cos.StrKVs
+	)
 	for fspath := range c.Paths {
 		mpath, err := ValidateMpath(fspath)
 		if err != nil {
 			return err
 		}
+		if domain, ok := c.Domains[fspath]; ok {
+			if cleanDomains == nil {
+				cleanDomains = make(cos.StrKVs, len(c.Domains))
+			}
+			cleanDomains[mpath] = domain
+		}
 		l := len(mpath)
 		// disallow mountpath nesting
 		for mpath2 := range cleanMpaths {
@@ -1377,7 +1468,7 @@ func (c *FSPConf) Validate(contextConfig *Config) error {
 		}
 		cleanMpaths[mpath] = struct{}{}
 	}
-	c.Paths = cleanMpaths
+	c.Paths, c.Domains = cleanMpaths, cleanDomains
 	return nil
 }
 
@@ -1508,6 +1599,9 @@ func (c *TransportConf) Validate() (err error) {
 	if c.MaxHeaderSize > 0 && c.MaxHeaderSize < 512 {
 		return fmt.Errorf("invalid transport.max_header: %v (expected >= 512)", c.MaxHeaderSize)
 	}
+	if c.MaxRxStreams < 0 {
+		return fmt.Errorf("invalid transport.max_rx_streams: %v (expected >= 0)", c.MaxRxStreams)
+	}
 	return nil
 }
 
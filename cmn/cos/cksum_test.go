@@ -0,0 +1,71 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"crypto/rand"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ChecksumFile", func() {
+	var (
+		tmpDir string
+		buf    = make([]byte, 4*cos.KiB)
+	)
+	writeFile := func(name string, size int) (fqn string, data []byte) {
+		fqn = filepath.Join(tmpDir, name)
+		data = make([]byte, size)
+		_, _ = rand.Read(data)
+		Expect(os.WriteFile(fqn, data, 0o644)).NotTo(HaveOccurred())
+		return
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "cksum")
+		Expect(err).NotTo(HaveOccurred())
+	})
+	AfterEach(func() {
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("should compute all supported checksums", func() {
+		fqn, data := writeFile("obj", 100*cos.KiB+3)
+		for _, ty := range cos.SupportedChecksums() {
+			if ty == cos.ChecksumNone {
+				continue
+			}
+			expected, err := cos.ChecksumBytes(data, ty)
+			Expect(err).NotTo(HaveOccurred())
+			cksum, err := cos.ChecksumFile(fqn, ty, buf)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cksum.Ty()).To(Equal(ty))
+			Expect(cksum.Equal(expected)).To(BeTrue(), ty)
+		}
+	})
+
+	It("should checksum empty file", func() {
+		fqn, _ := writeFile("empty", 0)
+		expected, err := cos.ChecksumBytes(nil, cos.ChecksumXXHash)
+		Expect(err).NotTo(HaveOccurred())
+		cksum, err := cos.ChecksumFile(fqn, cos.ChecksumXXHash, buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cksum.Value()).NotTo(BeEmpty())
+		Expect(cksum.Equal(expected)).To(BeTrue())
+	})
+
+	It("should fail on invalid type and missing file", func() {
+		fqn, _ := writeFile("obj", 10)
+		_, err := cos.ChecksumFile(fqn, "crc64", buf)
+		Expect(err).To(HaveOccurred())
+		_, err = cos.ChecksumFile(filepath.Join(tmpDir, "nonexistent"), cos.ChecksumXXHash, buf)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -21,6 +21,7 @@ const (
 	HdrAccept                = "Accept"
 	HdrLocation              = "Location"
 	HdrServer                = "Server"
+	HdrRetryAfter            = "Retry-After"
 	HdrETag                  = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Hdrs/ETag
 )
 
@@ -60,6 +61,7 @@ const (
 	ContentJSON           = "application/json"
 	ContentJSONCharsetUTF = "application/json; charset=utf-8"
 	ContentMsgPack        = "application/msgpack"
+	ContentNDJSON         = "application/x-ndjson"
 	ContentXML            = "application/xml"
 	ContentBinary         = "application/octet-stream"
 )
@@ -5,6 +5,7 @@
 package cos
 
 import (
+	"math"
 	"strings"
 	"time"
 
@@ -16,9 +17,52 @@ import (
 
 type Duration time.Duration
 
+func DurationSince(t time.Time) Duration     { return Duration(time.Since(t)) }
+func DurationFromSecs(secs float64) Duration { return Duration(secs * float64(time.Second)) }
+
 func (d Duration) D() time.Duration             { return time.Duration(d) }
+func (d Duration) Secs() float64                { return time.Duration(d).Seconds() }
 func (d Duration) MarshalJSON() ([]byte, error) { return jsoniter.Marshal(d.String()) }
 
+// Saturating arithmetic: instead of overflowing (and wrapping around into
+// a negative duration that'd then effectively disable a timeout), results
+// get clamped to the [0, math.MaxInt64] range.
+
+func (d Duration) Add(other Duration) Duration {
+	switch {
+	case other > 0 && d > math.MaxInt64-other:
+		return math.MaxInt64
+	case other < 0 && d < math.MinInt64-other:
+		return 0
+	}
+	return Duration(0).Max(d + other)
+}
+
+func (d Duration) Mul(factor float64) Duration {
+	r := float64(d) * factor
+	switch {
+	case r != r || r <= 0: // (NaN)
+		return 0
+	case r >= math.MaxInt64:
+		return math.MaxInt64
+	}
+	return Duration(r)
+}
+
+func (d Duration) Min(other Duration) Duration {
+	if d < other {
+		return d
+	}
+	return other
+}
+
+func (d Duration) Max(other Duration) Duration {
+	if d > other {
+		return d
+	}
+	return other
+}
+
 func (d Duration) String() (s string) {
 	s = time.Duration(d).String()
 	// see related: https://github.com/golang/go/issues/39064
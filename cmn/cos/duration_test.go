@@ -0,0 +1,84 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2022, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestDurationSecs(t *testing.T) {
+	tests := []float64{0, 0.001, 0.5, 1, 1.5, 60, 90, 3600, 86400 * 7}
+	for _, secs := range tests {
+		d := DurationFromSecs(secs)
+		tassert.Errorf(t, d.Secs() == secs, "%v: expected %f secs, got %f", d, secs, d.Secs())
+
+		b, err := jsoniter.Marshal(d)
+		tassert.CheckFatal(t, err)
+		var d2 Duration
+		err = jsoniter.Unmarshal(b, &d2)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, d == d2, "round-trip %s: %v != %v", string(b), d, d2)
+		tassert.Errorf(t, d2.Secs() == secs, "round-trip %s: expected %f secs, got %f", string(b), secs, d2.Secs())
+	}
+}
+
+// saturating arithmetic: clamped rather than wrapped around
+func TestDurationSaturate(t *testing.T) {
+	const (
+		maxd = Duration(math.MaxInt64)
+		hour = Duration(time.Hour)
+	)
+	tests := []struct {
+		name     string
+		got, exp Duration
+	}{
+		{"add", hour.Add(hour), 2 * hour},
+		{"add-negative", hour.Add(-2 * hour), 0},
+		{"add-near-max", (maxd - hour).Add(hour), maxd},
+		{"add-overflow", (maxd - hour).Add(2 * hour), maxd},
+		{"add-max-max", maxd.Add(maxd), maxd},
+		{"add-underflow", Duration(math.MinInt64).Add(-hour), 0},
+		{"mul", hour.Mul(2.5), 5 * hour / 2},
+		{"mul-zero", hour.Mul(0), 0},
+		{"mul-negative", hour.Mul(-1), 0},
+		{"mul-nan", hour.Mul(math.NaN()), 0},
+		{"mul-inf", hour.Mul(math.Inf(1)), maxd},
+		{"mul-overflow", (maxd / 2).Mul(3), maxd},
+		{"mul-max", maxd.Mul(1), maxd},
+		{"min", hour.Min(2 * hour), hour},
+		{"max", hour.Max(2 * hour), 2 * hour},
+		{"min-max", maxd.Min(hour).Max(0), hour},
+	}
+	for _, test := range tests {
+		tassert.Errorf(t, test.got == test.exp, "%s: expected %d, got %d", test.name, test.exp, test.got)
+	}
+
+	// exponential backoff never goes negative
+	d := Duration(time.Second)
+	for i := 0; i < 100; i++ {
+		next := d.Mul(2)
+		tassert.Fatalf(t, next >= d, "step %d: %d => %d", i, d, next)
+		d = next
+	}
+	tassert.Errorf(t, d == maxd, "expected backoff to saturate at %d, got %d", maxd, d)
+}
+
+func TestDurationSince(t *testing.T) {
+	started := time.Now().Add(-time.Minute)
+	d := DurationSince(started)
+	tassert.Errorf(t, d.D() >= time.Minute && d.D() < 2*time.Minute, "unexpected duration %v", d)
+
+	b, err := jsoniter.Marshal(d)
+	tassert.CheckFatal(t, err)
+	var d2 Duration
+	err = jsoniter.Unmarshal(b, &d2)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, d == d2, "round-trip %s: %v != %v", string(b), d, d2)
+}
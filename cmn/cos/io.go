@@ -491,6 +491,26 @@ func CopyFile(src, dst string, buf []byte, cksumType string) (written int64, cks
 	return
 }
 
+// reads the file once to compute its checksum of a given type (compare with CopyFile above);
+// the result is comparable with the stored one via CksumHash.Equal
+func ChecksumFile(fqn, ty string, buf []byte) (cksum *CksumHash, err error) {
+	if err = ValidateCksumType(ty); err != nil {
+		return
+	}
+	var file *os.File
+	if file, err = os.Open(fqn); err != nil {
+		return
+	}
+	cksum = NewCksumHash(ty)
+	_, err = io.CopyBuffer(cksum.H, file, buf)
+	Close(file)
+	if err != nil {
+		return nil, err
+	}
+	cksum.Finalize()
+	return
+}
+
 // Saves the reader directly to a local file, xxhash-checksums if requested
 func SaveReader(fqn string, reader io.Reader, buf []byte, cksumType string,
 	size int64, dirMustExist string) (cksum *CksumHash, err error) {
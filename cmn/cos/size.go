@@ -6,6 +6,7 @@ package cos
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
@@ -41,20 +42,34 @@ func (siz *Size) UnmarshalJSON(b []byte) (err error) {
 // helpers
 //
 
+// S2B parses human-readable size - the inverse of B2S. Case-insensitive; the number
+// may be fractional (e.g., "2.5GiB") but not negative; the suffix, if present, is one of:
+// B, K, KB, KiB, M, MB, MiB, G, GB, GiB, T, TB, TiB.
+// NOTE: both KB and KiB (and, respectively, MB, GB, TB) denote powers of 1024 - the
+// convention that existing configurations (e.g., "32kb" for `memsys.default_buf`) rely upon
 func S2B(s string) (int64, error) {
 	if s == "" {
 		return 0, nil
 	}
-	s = strings.ToUpper(s)
+	us := strings.ToUpper(strings.TrimSpace(s))
+	mult, ns := int64(1), strings.TrimSuffix(us, "B")
 	for k, v := range toBiBytes {
-		if ns := strings.TrimSuffix(s, k); ns != s {
-			f, err := strconv.ParseFloat(strings.TrimSpace(ns), 64)
-			return int64(float64(v) * f), err
+		if n := strings.TrimSuffix(us, k); n != us {
+			mult, ns = v, n
+			break
 		}
 	}
-	ns := strings.TrimSuffix(s, "B")
 	f, err := strconv.ParseFloat(strings.TrimSpace(ns), 64)
-	return int64(f), err
+	if err != nil || math.IsNaN(f) {
+		return 0, fmt.Errorf("invalid size %q (expecting a number with an optional suffix, e.g. \"64KiB\" or \"2.5GB\")", s)
+	}
+	if f < 0 {
+		return 0, fmt.Errorf("invalid size %q: negative", s)
+	}
+	if f*float64(mult) >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid size %q: out of range", s)
+	}
+	return int64(float64(mult) * f), nil
 }
 
 func B2S(b int64, digits int) string {
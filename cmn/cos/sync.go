@@ -195,6 +195,7 @@ func (s *DynSemaphore) SetSize(n int) {
 	Assert(n >= 1)
 	s.mu.Lock()
 	s.size = n
+	s.c.Broadcast() // (in case it grew)
 	s.mu.Unlock()
 }
 
@@ -115,6 +115,9 @@ type (
 		name   string // object's name
 		d1, d2 uint64 // lom.md.(bucket-ID) and lom.bck.(bucket-ID), respectively
 	}
+	ErrObjExists struct {
+		name string // destination (e.g., copy) object's name
+	}
 	ErrAborted struct {
 		err  error
 		what string
@@ -488,6 +491,21 @@ func isErrObjDefunct(err error) bool {
 	return ok
 }
 
+// ErrObjExists
+
+func (e *ErrObjExists) Error() string {
+	return fmt.Sprintf("%s already exists", e.name)
+}
+
+func NewErrObjExists(name string) *ErrObjExists {
+	return &ErrObjExists{name}
+}
+
+func IsErrObjExists(err error) bool {
+	_, ok := err.(*ErrObjExists)
+	return ok
+}
+
 // ErrAborted
 
 func NewErrAborted(what, ctx string, err error) *ErrAborted {
@@ -23,6 +23,12 @@ const (
 	SkipVC                    // skip loading existing object's metadata, Version and Checksum in particular
 	DontAutoDetectFshare      // when promoting NFS shares to AIS
 	ProvideS3APIViaRoot       // handle s3 compat via `aistore-hostname/` (default: `aistore-hostname/s3`)
+	VerifyCopiesOnLoad        // when loading LOM from disk, make sure that copies (replicas) belong to the same object
+	CompareCopyContent        // before keeping an existing copy, compare content unless checksums prove it's identical
+	SkipCopySpaceCheck        // do not check destination's free space prior to making a local copy (see cluster/lcopy.go)
+	TrustCopyChecksum         // copying between buckets with the same checksum type: carry over source checksum without recomputing
+	StrictCopyAntiAffinity    // refuse to place a copy in the failure domain that already holds one (see cluster/lcopy.go)
+	VerifyRestoreSources      // when restoring an object from its copies, recompute their checksums to prefer the ones that verify
 )
 
 var All = []string{
@@ -31,6 +37,12 @@ var All = []string{
 	"Skip-Loading-VersionChecksum-MD",
 	"Do-not-Auto-Detect-FileShare",
 	"Provide-S3-API-via-Root",
+	"Verify-Copies-On-Load",
+	"Compare-Copy-Content",
+	"Skip-Copy-Space-Check",
+	"Trust-Copy-Checksum",
+	"Strict-Copy-Anti-Affinity",
+	"Verify-Restore-Sources",
 }
 
 func (f Flags) IsSet(flag Flags) bool { return cos.BitFlags(f).IsSet(cos.BitFlags(flag)) }
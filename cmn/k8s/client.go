@@ -207,7 +207,7 @@ func GetClient() (Client, error) {
 }
 
 func _initClient() {
-	config, err := rest.InClusterConfig()
+	config, err := clientConfig(os.Getenv(k8sClientModeEnv))
 	if err != nil {
 		_defaultK8sClient = &defaultClient{err: err}
 		return
@@ -224,6 +224,31 @@ func _initClient() {
 	}
 }
 
+// client creation strategy is selected explicitly via `k8sClientModeEnv`:
+// - ClientInCluster: pod's service account (the only option for deployed pods);
+// - ClientKubeconfig: kubeconfig at $KUBECONFIG or, if unset, at ~/.kube/config
+// (e.g., when running AIS tooling on a dev machine against a remote K8s cluster);
+// - empty: auto - same as in-cluster
+func clientConfig(mode string) (*rest.Config, error) {
+	switch mode {
+	case "", ClientInCluster:
+		return rest.InClusterConfig()
+	case ClientKubeconfig:
+		path := os.Getenv(clientcmd.RecommendedConfigPathEnvVar)
+		if path == "" {
+			path = clientcmd.RecommendedHomeFile
+		}
+		config, err := clientcmd.BuildConfigFromFlags("", path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load kubeconfig %q: %v", path, err)
+		}
+		return config, nil
+	default:
+		return nil, fmt.Errorf("invalid %s=%q (expecting %q, %q, or empty for auto)",
+			k8sClientModeEnv, mode, ClientInCluster, ClientKubeconfig)
+	}
+}
+
 func getMetricsClient() (*metrics.Clientset, error) {
 	_metricsClientOnce.Do(_initMetricsClient)
 
@@ -234,16 +259,16 @@ func getMetricsClient() (*metrics.Clientset, error) {
 	return _defaultMetricsClient.client, nil
 }
 
+// (uses the same config as the default client - see clientConfig)
 func _initMetricsClient() {
-	config, err := clientcmd.BuildConfigFromFlags("", "")
-	if err != nil {
+	if _, err := GetClient(); err != nil {
 		_defaultMetricsClient = &metricsClient{
 			err: fmt.Errorf("failed to retrieve metrics client config; err: %v", err),
 		}
 		return
 	}
 
-	mc, err := metrics.NewForConfig(config)
+	mc, err := metrics.NewForConfig(_defaultK8sClient.config)
 	if err != nil {
 		_defaultMetricsClient = &metricsClient{
 			err: fmt.Errorf("failed to create metrics client; err: %v", err),
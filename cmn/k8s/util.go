@@ -9,82 +9,178 @@ import (
 	"os"
 	"strings"
 	"sync"
+	gatomic "sync/atomic"
+	"time"
 
 	"github.com/NVIDIA/aistore/3rdparty/glog"
-	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 )
 
 const (
-	k8sPodNameEnv  = "HOSTNAME"
-	k8sNodeNameEnv = "K8S_NODE_NAME"
+	k8sPodNameEnv     = "HOSTNAME"
+	k8sNodeNameEnv    = "K8S_NODE_NAME"
+	k8sNodeRefreshEnv = "K8S_NODE_REFRESH" // optional: periodically re-query node identity (e.g., "10m")
+	k8sClientModeEnv  = "K8S_CLIENT_MODE"  // optional: ClientInCluster or ClientKubeconfig (default: auto)
+
+	// K8s client creation modes (see clientConfig)
+	ClientInCluster  = "in-cluster"
+	ClientKubeconfig = "kubeconfig"
 
 	Default = "default"
 	Pod     = "pod"
 	Svc     = "svc"
 )
 
+// type of deployment, as concluded by initDetect (see DeploymentKind)
+const (
+	KindUnknown    Kind = iota // K8s environment but failed to resolve the node (see lookupNode)
+	KindStandalone             // non-Kubernetes deployment
+	KindK8s                    // Kubernetes deployment
+)
+
+type Kind int
+
 var (
 	detectOnce sync.Once
-	NodeName   string
+	nodeName   gatomic.Value // (string) see CurrentNode
+	kind       Kind          // written once by initDetect
 )
 
 func initDetect() {
 	var (
-		pod *v1.Pod
-
-		nodeName = os.Getenv(k8sNodeNameEnv)
-		podName  = os.Getenv(k8sPodNameEnv)
+		envNode = os.Getenv(k8sNodeNameEnv)
+		podName = os.Getenv(k8sPodNameEnv)
 	)
 
 	glog.Infof(
-		"Verifying type of deployment (%s: %q, %s: %q)",
-		k8sPodNameEnv, podName, k8sNodeNameEnv, nodeName,
+		"Verifying type of deployment (%s: %q, %s: %q, %s: %q)",
+		k8sPodNameEnv, podName, k8sNodeNameEnv, envNode, k8sClientModeEnv, os.Getenv(k8sClientModeEnv),
 	)
 
 	client, err := GetClient()
 	if err != nil {
-		glog.Infof("Couldn't initiate a K8s client, assuming non-Kubernetes deployment")
+		glog.Infof("Couldn't initiate a K8s client (%v), assuming non-Kubernetes deployment", err)
+		kind = KindStandalone
 		return
 	}
 
 	// If the `k8sNodeNameEnv` is set then we should just use it as we trust it
 	// more than anything else.
-	if nodeName != "" {
-		goto checkNode
-	}
-
-	if podName == "" {
+	if envNode == "" && podName == "" {
 		glog.Infof("%s environment not found, assuming non-Kubernetes deployment", k8sPodNameEnv)
+		kind = KindStandalone
 		return
 	}
-
-	pod, err = client.Pod(podName)
+	name, err := lookupNode(client, envNode, podName)
 	if err != nil {
-		glog.Errorf("Failed to get pod %q, err: %v. Try setting %q env variable", podName, err, k8sNodeNameEnv)
-		return
+		glog.Error(err)
+		return // (KindUnknown)
 	}
-	nodeName = pod.Spec.NodeName
+	nodeName.Store(name)
+	kind = KindK8s
+	glog.Infof("Successfully got node name %q, assuming Kubernetes deployment", name)
+
+	if s := os.Getenv(k8sNodeRefreshEnv); s != "" {
+		interval, err := time.ParseDuration(s)
+		if err != nil {
+			glog.Errorf("Invalid %s=%q (expecting duration), err: %v", k8sNodeRefreshEnv, s, err)
+			return
+		}
+		if interval > 0 {
+			go refresh(client, envNode, podName, interval)
+		}
+	}
+}
 
-checkNode:
-	node, err := client.Node(nodeName)
+// NOTE: minimal-RBAC deployments - the service account may be able to read its own pod
+// but not (cluster-scoped) nodes; in that case, trust `pod.Spec.NodeName`
+func lookupNode(client Client, envNode, podName string) (string, error) {
+	var (
+		name     = envNode
+		fromSpec bool
+	)
+	if name == "" {
+		pod, err := client.Pod(podName)
+		if err != nil {
+			return "", fmt.Errorf("failed to get pod %q, err: %v. Try setting %q env variable",
+				podName, err, k8sNodeNameEnv)
+		}
+		name, fromSpec = pod.Spec.NodeName, pod.Spec.NodeName != ""
+	}
+	node, err := client.Node(name)
 	if err != nil {
-		glog.Errorf("Failed to get node %q, err: %v. Try setting %q env variable", nodeName, err, k8sNodeNameEnv)
-		return
+		if fromSpec && errors.IsForbidden(err) {
+			glog.Warningf("Not permitted to get node %q (%v) - using pod %q spec", name, err, podName)
+			return name, nil
+		}
+		return "", fmt.Errorf("failed to get node %q, err: %v. Try setting %q env variable",
+			name, err, k8sNodeNameEnv)
 	}
+	return node.Name, nil
+}
+
+// keep re-querying the node (e.g., upon pod rescheduling or live migration);
+// upon failure, keep the last known name
+func refresh(client Client, envNode, podName string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		name, err := lookupNode(client, envNode, podName)
+		if err != nil {
+			glog.Errorf("Failed to refresh node name (keeping %q): %v", CurrentNode(), err)
+			continue
+		}
+		if prev := CurrentNode(); name != prev {
+			nodeName.Store(name)
+			glog.Warningf("Node name changed: %q => %q", prev, name)
+		}
+	}
+}
 
-	NodeName = node.Name
-	glog.Infof("Successfully got node name %q, assuming Kubernetes deployment", NodeName)
+// returns the (last known) name of the K8s node this process is running on,
+// or empty string when not deployed in K8s (see Detect)
+func CurrentNode() string {
+	if name, ok := nodeName.Load().(string); ok {
+		return name
+	}
+	return ""
 }
 
+// Detect is a guard for operations that require Kubernetes; to find out
+// the type of deployment without treating non-K8s as an error, use IsK8s
+// or DeploymentKind
 func Detect() error {
 	detectOnce.Do(initDetect)
 
-	if NodeName == "" {
+	if CurrentNode() == "" {
 		return fmt.Errorf("the operation requires Kubernetes")
 	}
 	return nil
 }
 
+func DeploymentKind() Kind {
+	detectOnce.Do(initDetect)
+	return kind
+}
+
+// returns (true, true) for K8s, (false, true) for standalone, and
+// (false, false) when the detection itself failed
+func IsK8s() (yes, detected bool) {
+	k := DeploymentKind()
+	return k == KindK8s, k != KindUnknown
+}
+
+func (k Kind) String() string {
+	switch k {
+	case KindStandalone:
+		return "standalone"
+	case KindK8s:
+		return "kubernetes"
+	default:
+		return "unknown"
+	}
+}
+
 // POD name (K8s doesn't allow `_` and uppercase)
 func CleanName(name string) string { return strings.ReplaceAll(strings.ToLower(name), "_", "-") }
 
@@ -39,6 +39,8 @@ type (
 		UUID              string      `json:"uuid"`
 		ContinuationToken string      `json:"continuation_token"`
 		Entries           []*LsoEntry `json:"entries"`
+		Missing           []string    `json:"missing,omitempty"`    // requested but not found (see LsoMsg.ObjNames)
+		Incomplete        []string    `json:"incomplete,omitempty"` // IDs of the targets that failed to list (see apc.LsAllowPartial)
 		Flags             uint32      `json:"flags"`
 	}
 )
@@ -54,6 +56,13 @@ func (be *LsoEntry) Status() uint16     { return be.Flags & apc.EntryStatusMask
 func (be *LsoEntry) IsInsideArch() bool { return be.Flags&apc.EntryInArch != 0 }
 func (be *LsoEntry) String() string     { return "{" + be.Name + "}" }
 
+// (see apc.GetPropsReplication)
+func (be *LsoEntry) IsUnderReplicated() bool { return be.Flags&apc.EntryUnderReplicated != 0 }
+func (be *LsoEntry) IsOverReplicated() bool  { return be.Flags&apc.EntryOverReplicated != 0 }
+
+// (see apc.GetPropsPlacement)
+func (be *LsoEntry) IsMisplaced() bool { return be.Flags&apc.EntryMisplaced != 0 }
+
 func (be *LsoEntry) CopyWithProps(propsSet cos.StrSet) (ne *LsoEntry) {
 	ne = &LsoEntry{Name: be.Name}
 	if propsSet.Contains(apc.GetPropsSize) {
@@ -77,5 +86,13 @@ func (be *LsoEntry) CopyWithProps(propsSet cos.StrSet) (ne *LsoEntry) {
 	if propsSet.Contains(apc.GetPropsCopies) {
 		ne.Copies = be.Copies
 	}
+	if propsSet.Contains(apc.GetPropsReplication) {
+		ne.Copies = be.Copies
+		ne.Flags |= be.Flags & (apc.EntryUnderReplicated | apc.EntryOverReplicated)
+	}
+	if propsSet.Contains(apc.GetPropsPlacement) {
+		ne.Location = be.Location
+		ne.Flags |= be.Flags & apc.EntryMisplaced
+	}
 	return
 }
@@ -312,6 +312,44 @@ func (z *LsoResult) DecodeMsg(dc *msgp.Reader) (err error) {
 					}
 				}
 			}
+		case "Missing":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Missing")
+				return
+			}
+			if cap(z.Missing) >= int(zb0003) {
+				z.Missing = (z.Missing)[:zb0003]
+			} else {
+				z.Missing = make([]string, zb0003)
+			}
+			for za0002 := range z.Missing {
+				z.Missing[za0002], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Missing", za0002)
+					return
+				}
+			}
+		case "Incomplete":
+			var zb0004 uint32
+			zb0004, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Incomplete")
+				return
+			}
+			if cap(z.Incomplete) >= int(zb0004) {
+				z.Incomplete = (z.Incomplete)[:zb0004]
+			} else {
+				z.Incomplete = make([]string, zb0004)
+			}
+			for za0003 := range z.Incomplete {
+				z.Incomplete[za0003], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Incomplete", za0003)
+					return
+				}
+			}
 		case "Flags":
 			z.Flags, err = dc.ReadUint32()
 			if err != nil {
@@ -331,9 +369,9 @@ func (z *LsoResult) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *LsoResult) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 4
+	// map header, size 6
 	// write "UUID"
-	err = en.Append(0x84, 0xa4, 0x55, 0x55, 0x49, 0x44)
+	err = en.Append(0x86, 0xa4, 0x55, 0x55, 0x49, 0x44)
 	if err != nil {
 		return
 	}
@@ -376,6 +414,40 @@ func (z *LsoResult) EncodeMsg(en *msgp.Writer) (err error) {
 			}
 		}
 	}
+	// write "Missing"
+	err = en.Append(0xa7, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Missing)))
+	if err != nil {
+		err = msgp.WrapError(err, "Missing")
+		return
+	}
+	for za0002 := range z.Missing {
+		err = en.WriteString(z.Missing[za0002])
+		if err != nil {
+			err = msgp.WrapError(err, "Missing", za0002)
+			return
+		}
+	}
+	// write "Incomplete"
+	err = en.Append(0xaa, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Incomplete)))
+	if err != nil {
+		err = msgp.WrapError(err, "Incomplete")
+		return
+	}
+	for za0003 := range z.Incomplete {
+		err = en.WriteString(z.Incomplete[za0003])
+		if err != nil {
+			err = msgp.WrapError(err, "Incomplete", za0003)
+			return
+		}
+	}
 	// write "Flags"
 	err = en.Append(0xa5, 0x46, 0x6c, 0x61, 0x67, 0x73)
 	if err != nil {
@@ -399,6 +471,14 @@ func (z *LsoResult) Msgsize() (s int) {
 			s += z.Entries[za0001].Msgsize()
 		}
 	}
+	s += 8 + msgp.ArrayHeaderSize
+	for za0002 := range z.Missing {
+		s += msgp.StringPrefixSize + len(z.Missing[za0002])
+	}
+	s += 11 + msgp.ArrayHeaderSize
+	for za0003 := range z.Incomplete {
+		s += msgp.StringPrefixSize + len(z.Incomplete[za0003])
+	}
 	s += 6 + msgp.Uint32Size
 	return
 }
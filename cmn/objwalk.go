@@ -6,11 +6,14 @@
 package cmn
 
 import (
+	"bufio"
+	"io"
 	"sort"
 	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
 )
 
 type LsoEntries []*LsoEntry // separately from (code-generated) objlist* - no need to msgpack
@@ -156,3 +159,28 @@ func DirNameContainsPrefix(dirPath, prefix string) bool {
 func ObjNameContainsPrefix(objName, prefix string) bool {
 	return prefix == "" || strings.HasPrefix(objName, prefix)
 }
+
+// LsoDecoder reads streamed (NDJSON) list-objects pages: one JSON-encoded entry
+// per line (see apc.QparamLsoStream)
+type LsoDecoder struct {
+	br *bufio.Reader
+}
+
+func NewLsoDecoder(r io.Reader) *LsoDecoder { return &LsoDecoder{br: bufio.NewReader(r)} }
+
+// returns io.EOF when done; a last line that is not newline-terminated indicates
+// a broken stream
+func (d *LsoDecoder) Next() (*LsoEntry, error) {
+	line, err := d.br.ReadBytes('\n')
+	if err != nil {
+		if err == io.EOF && len(line) > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	e := &LsoEntry{}
+	if err := jsoniter.Unmarshal(line, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
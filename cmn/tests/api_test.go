@@ -186,4 +186,60 @@ var _ = Describe("API", func() {
 			),
 		)
 	})
+
+	Describe("BsummSizeHist", func() {
+		It("should validate and default histogram bounds", func() {
+			msg := cmn.BsummCtrlMsg{SizeHist: true}
+			Expect(msg.Validate()).NotTo(HaveOccurred())
+			Expect(msg.HistBounds).To(Equal(cmn.DfltSizeHistBounds))
+
+			msg = cmn.BsummCtrlMsg{SizeHist: true, HistBounds: []int64{1024, 1024}}
+			Expect(msg.Validate()).To(HaveOccurred())
+			msg = cmn.BsummCtrlMsg{HistBounds: []int64{1024}}
+			Expect(msg.Validate()).To(HaveOccurred())
+		})
+
+		It("should count and aggregate object sizes", func() {
+			var (
+				bck     = cmn.Bck{Name: "hist", Provider: apc.AIS}
+				summ1   = cmn.NewBsummResult(&bck, 0)
+				summ2   = cmn.NewBsummResult(&bck, 0)
+				bounds  = []int64{10, 100}
+				summary cmn.AllBsummResults
+			)
+			summ1.SizeHist = cmn.NewBsummSizeHist(bounds)
+			summ2.SizeHist = cmn.NewBsummSizeHist(bounds)
+			for _, size := range []int64{0, 9, 10, 99, 100} {
+				summ1.SizeHist.Add(size)
+			}
+			summ2.SizeHist.Add(1000)
+
+			summary = summary.Aggregate(summ1)
+			summary = summary.Aggregate(summ2)
+			Expect(summary).To(HaveLen(1))
+			Expect(summary[0].SizeHist.Counts).To(Equal([]uint64{2, 2, 2}))
+		})
+	})
+
+	Describe("BsummTally", func() {
+		It("should aggregate local tallies while taking the remote one as is", func() {
+			var (
+				bck     = cmn.Bck{Name: "tally", Provider: apc.AWS}
+				summ1   = cmn.NewBsummResult(&bck, 0)
+				summ2   = cmn.NewBsummResult(&bck, 0)
+				summary cmn.AllBsummResults
+			)
+			summ1.ObjCount.Present, summ1.TotalSize.PresentObjs = 2, 200
+			summ2.ObjCount.Present, summ2.TotalSize.PresentObjs = 3, 300
+			summ2.Remote = &cmn.BsummTally{ObjCount: 10, Size: 1000}
+
+			summary = summary.Aggregate(summ1)
+			Expect(summary[0].Remote).To(BeNil())
+			summary = summary.Aggregate(summ2)
+			Expect(summary).To(HaveLen(1))
+			Expect(summary[0].ObjCount.Present).To(BeEquivalentTo(5))
+			Expect(summary[0].TotalSize.PresentObjs).To(BeEquivalentTo(500))
+			Expect(*summary[0].Remote).To(Equal(cmn.BsummTally{ObjCount: 10, Size: 1000}))
+		})
+	})
 })
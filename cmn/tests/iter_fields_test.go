@@ -66,9 +66,15 @@ var _ = Describe("IterFields", func() {
 					"backend_bck.name":     "name",
 					"backend_bck.provider": apc.GCP,
 
-					"mirror.enabled":      false,
-					"mirror.copies":       int64(0),
-					"mirror.burst_buffer": 0,
+					"mirror.enabled":        false,
+					"mirror.copies":         int64(0),
+					"mirror.burst_buffer":   0,
+					"mirror.heal_on_get":    false,
+					"mirror.lazy_cksum":     false,
+					"mirror.mpaths":         []string(nil),
+					"mirror.age_limit":      cos.Duration(0),
+					"mirror.cold_copies":    int64(0),
+					"mirror.disable_lb_get": false,
 
 					"ec.enabled":           true,
 					"ec.parity_slices":     1024,
@@ -120,9 +126,15 @@ var _ = Describe("IterFields", func() {
 					"backend_bck.name":     (*string)(nil),
 					"backend_bck.provider": (*string)(nil),
 
-					"mirror.enabled":      (*bool)(nil),
-					"mirror.copies":       (*int64)(nil),
-					"mirror.burst_buffer": (*int)(nil),
+					"mirror.enabled":        (*bool)(nil),
+					"mirror.copies":         (*int64)(nil),
+					"mirror.burst_buffer":   (*int)(nil),
+					"mirror.heal_on_get":    (*bool)(nil),
+					"mirror.lazy_cksum":     (*bool)(nil),
+					"mirror.mpaths":         (*[]string)(nil),
+					"mirror.age_limit":      (*cos.Duration)(nil),
+					"mirror.cold_copies":    (*int64)(nil),
+					"mirror.disable_lb_get": (*bool)(nil),
 
 					"ec.enabled":           api.Bool(true),
 					"ec.parity_slices":     api.Int(1024),
@@ -37,7 +37,7 @@ const (
 	MetaverVMD   = 1 // Volume MD (jsp)
 	MetaverEtlMD = 1 // ETL MD (jsp)
 
-	MetaverLOM = 1 // LOM
+	MetaverLOM = 2 // LOM (v2 adds per-object num copies and pinned copies; v1 is still written when neither is set)
 
 	MetaverConfig      = 2 // Global Configuration (jsp)
 	MetaverAuthNConfig = 1 // Authn config (jsp) // ditto
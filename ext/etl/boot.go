@@ -240,7 +240,7 @@ func (b *etlBootstrapper) _setAffinity() error {
 				MatchExpressions: []corev1.NodeSelectorRequirement{{
 					Key:      nodeNameLabel,
 					Operator: corev1.NodeSelectorOpIn,
-					Values:   []string{k8s.NodeName},
+					Values:   []string{k8s.CurrentNode()},
 				}},
 			},
 		},
@@ -280,7 +280,7 @@ func (b *etlBootstrapper) _updPodLabels() {
 
 	b.pod.Labels[appLabel] = "ais"
 	b.pod.Labels[podNameLabel] = b.pod.GetName()
-	b.pod.Labels[podNodeLabel] = k8s.NodeName
+	b.pod.Labels[podNodeLabel] = k8s.CurrentNode()
 	b.pod.Labels[podTargetLabel] = b.t.SID()
 	b.pod.Labels[appK8sNameLabel] = "etl"
 	b.pod.Labels[appK8sComponentLabel] = "server"
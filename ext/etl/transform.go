@@ -246,7 +246,7 @@ func cleanupEntities(errCtx *cmn.ETLErrCtx, podName, svcName string) (err error)
 // * svcName - non-empty if at least one attempt of creating service was executed
 // * err - any error occurred that should be passed on.
 func start(t cluster.Target, msg *InitSpecMsg, xactID string, opts StartOpts) (errCtx *cmn.ETLErrCtx, podName, svcName string, err error) {
-	debug.Assert(k8s.NodeName != "") // checked above
+	debug.Assert(k8s.CurrentNode() != "") // checked above
 
 	errCtx = &cmn.ETLErrCtx{TID: t.SID(), ETLName: msg.IDX}
 	boot := &etlBootstrapper{errCtx: errCtx, t: t, env: opts.Env}
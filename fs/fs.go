@@ -50,6 +50,7 @@ type (
 		Path           string   // clean path
 		FilesystemInfo          // underlying filesystem
 		Disks          []string // owned disks (ios.FsDisks map => slice)
+		Domain         string   // failure domain, if configured (see cmn.FSPConf.Domains)
 		bpc            struct {
 			m map[uint64]string
 			sync.RWMutex
@@ -89,6 +90,10 @@ type (
 
 		mu sync.RWMutex
 
+		// number of object copies (replicas) per mountpath: mpath => *atomic.Int64
+		// (see CopyCountByMpath)
+		copies sync.Map
+
 		// allow disk sharing by multiple mountpaths and mountpaths with no disks whatsoever
 		// (default = false)
 		allowSharedDisksAndNoDisks bool
@@ -126,6 +131,7 @@ func NewMountpath(mpath string) (mi *MountpathInfo, err error) {
 	mi = &MountpathInfo{
 		Path:           cleanMpath,
 		FilesystemInfo: fsInfo,
+		Domain:         cmn.GCO.Get().FSP.Domains[cleanMpath],
 		PathDigest:     xxhash.ChecksumString64S(cleanMpath, cos.MLCG32),
 	}
 	mi.bpc.m = make(map[uint64]string, 16)
@@ -437,6 +443,21 @@ func (mi *MountpathInfo) getCapacity(config *cmn.Config, refresh bool) (c Capaci
 	return
 }
 
+// returns the most recently refreshed capacity (see RefreshCapStatus)
+func (mi *MountpathInfo) GetCapacity() (c Capacity) {
+	mi.cmu.RLock()
+	c = mi.capacity
+	mi.cmu.RUnlock()
+	return
+}
+
+// NOTE: testing only
+func (mi *MountpathInfo) TestSetCapacity(c Capacity) {
+	mi.cmu.Lock()
+	mi.capacity = c
+	mi.cmu.Unlock()
+}
+
 //
 // mountpath add/enable helpers - always call under mfs lock
 //
@@ -580,8 +601,48 @@ func Decommission(mdOnly bool) {
 // `ios` delegations
 func GetAllMpathUtils() (utils *ios.MpathUtil) { return mfs.ios.GetAllMpathUtils() }
 func GetMpathUtil(mpath string) int64          { return mfs.ios.GetMpathUtil(mpath) }
+func GetMpathWbps(mpath string) int64          { return mfs.ios.GetMpathWbps(mpath) }
 func FillDiskStats(m ios.AllDiskStats)         { mfs.ios.FillDiskStats(m) }
 
+// object copies (replicas) created by the target since startup, by mountpath;
+// maintained by the LOM copy management (see cluster/lcopy.go) - does not scan.
+// NOTE: the counters start from zero upon every restart, which is why removing copies
+// that predate the (current) startup does not drive them below zero - the numbers are
+// a lower bound rather than the precise total on disk
+func IncCopies(mpath string) { _copies(mpath).Inc() }
+
+func DecCopies(mpath string) {
+	c := _copies(mpath)
+	for {
+		n := c.Load()
+		if n <= 0 || c.CAS(n, n-1) {
+			return
+		}
+	}
+}
+
+func _copies(mpath string) *atomic.Int64 {
+	if v, ok := mfs.copies.Load(mpath); ok {
+		return v.(*atomic.Int64)
+	}
+	v, _ := mfs.copies.LoadOrStore(mpath, atomic.NewInt64(0))
+	return v.(*atomic.Int64)
+}
+
+// returns the current (since-startup) number of copies for each available mountpath
+func CopyCountByMpath() map[string]int64 {
+	availablePaths := GetAvail()
+	counts := make(map[string]int64, len(availablePaths))
+	for mpath := range availablePaths {
+		var n int64
+		if v, ok := mfs.copies.Load(mpath); ok {
+			n = v.(*atomic.Int64).Load()
+		}
+		counts[mpath] = n
+	}
+	return counts
+}
+
 // TestDisableValidation disables fsid checking and allows mountpaths without disks (testing-only)
 func TestDisableValidation() { mfs.allowSharedDisksAndNoDisks = true }
 
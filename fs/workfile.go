@@ -0,0 +1,59 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// workfile left behind (e.g., by an interrupted copy) - see StaleWorkfiles
+type StaleWorkfile struct {
+	FQN string
+	Age time.Duration // since last modification
+}
+
+// StaleWorkfiles walks the bucket's workfile directories on all available mountpaths
+// and returns workfiles with the given prefix (e.g., WorkfileCopy) that have not been
+// modified for at least `olderThan`. Nothing gets removed - that's up to the caller.
+// Both workfile naming conventions are recognized:
+// - "<prefix>.<object name>" (see MakePathFQN), and
+// - "<dir>/<prefix>.<base name>.<tie>.<pid>" (see WorkfileContentResolver.GenUniqueFQN)
+func StaleWorkfiles(bck *cmn.Bck, prefix string, olderThan time.Duration) (stale []StaleWorkfile, err error) {
+	var (
+		avail = GetAvail()
+		now   = time.Now()
+		pref  = prefix + "."
+	)
+	for _, mi := range avail {
+		ctdir := mi.MakePathCT(bck, WorkfileType)
+		cb := func(fqn string, de DirEntry) error {
+			if de.IsDir() {
+				return nil
+			}
+			rel := strings.TrimPrefix(strings.TrimPrefix(fqn, ctdir), string(filepath.Separator))
+			if !strings.HasPrefix(rel, pref) && !strings.HasPrefix(filepath.Base(rel), pref) {
+				return nil
+			}
+			finfo, errN := os.Lstat(fqn)
+			if errN != nil {
+				return nil // removed in the meantime
+			}
+			if age := now.Sub(finfo.ModTime()); age >= olderThan {
+				stale = append(stale, StaleWorkfile{FQN: fqn, Age: age})
+			}
+			return nil
+		}
+		opts := &WalkOpts{Mi: mi, Bck: *bck, CTs: []string{WorkfileType}, Callback: cb}
+		if err = Walk(opts); err != nil {
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,81 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs_test
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestStaleWorkfiles(t *testing.T) {
+	bck := cmn.Bck{Name: "stale-work", Provider: apc.AIS}
+	fs.TestNew(mock.NewIOStater())
+	fs.TestDisableValidation()
+	_ = fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+
+	mpath, err := os.MkdirTemp("", "teststalework")
+	tassert.CheckFatal(t, err)
+	defer os.RemoveAll(mpath)
+	_, err = fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+
+	var (
+		mi    = fs.GetAvail()[mpath]
+		ctdir = mi.MakePathCT(&bck, fs.WorkfileType)
+		files = []struct {
+			name  string
+			age   time.Duration
+			stale bool
+		}{
+			{name: fs.WorkfileCopy + ".dir/subdir/obj", age: 2 * time.Hour, stale: true},
+			{name: "dir/" + fs.WorkfileCopy + ".obj.tie.1f", age: 90 * time.Minute, stale: true},
+			{name: fs.WorkfileCopy + ".fresh", age: time.Minute},
+			{name: "dir/" + fs.WorkfileCopy + ".young.tie.1f", age: 59 * time.Minute},
+			{name: fs.WorkfilePut + ".obj", age: 3 * time.Hour},           // different kind of workfile
+			{name: "dir/" + fs.WorkfilePut + ".copy", age: 3 * time.Hour}, // ditto
+		}
+		expected []string
+	)
+	for _, f := range files {
+		fqn := filepath.Join(ctdir, f.name)
+		fh, err := cos.CreateFile(fqn)
+		tassert.CheckFatal(t, err)
+		fh.Close()
+		mtime := time.Now().Add(-f.age)
+		tassert.CheckFatal(t, os.Chtimes(fqn, mtime, mtime))
+		if f.stale {
+			expected = append(expected, fqn)
+		}
+	}
+
+	stale, err := fs.StaleWorkfiles(&bck, fs.WorkfileCopy, time.Hour)
+	tassert.CheckFatal(t, err)
+	found := make([]string, 0, len(stale))
+	for _, s := range stale {
+		tassert.Errorf(t, s.Age >= time.Hour, "%q: unexpected age %v", s.FQN, s.Age)
+		found = append(found, s.FQN)
+	}
+	sort.Strings(found)
+	sort.Strings(expected)
+	tassert.Fatalf(t, len(found) == len(expected), "expected %v, got %v", expected, found)
+	for i := range found {
+		tassert.Errorf(t, found[i] == expected[i], "expected %q, got %q", expected[i], found[i])
+	}
+
+	// nothing removed
+	for _, f := range files {
+		tassert.CheckError(t, cos.Stat(filepath.Join(ctdir, f.name)))
+	}
+}
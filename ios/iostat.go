@@ -23,6 +23,7 @@ type (
 	IOStater interface {
 		GetAllMpathUtils() *MpathUtil
 		GetMpathUtil(mpath string) int64
+		GetMpathWbps(mpath string) int64
 		AddMpath(mpath string, fs string) (FsDisks, error)
 		RemoveMpath(mpath string)
 		FillDiskStats(m AllDiskStats)
@@ -50,6 +51,7 @@ type (
 
 		mpathUtil   map[string]int64 // Average utilization of the disks, range [0, 100].
 		mpathUtilRO MpathUtil        // Read-only copy of `mpathUtil`.
+		mpathWbps   sync.Map         // Write B/s (sum over the mountpath's disks), read-only.
 
 		expireTime int64
 		timestamp  int64
@@ -221,6 +223,14 @@ func (ios *ios) GetMpathUtil(mpath string) int64 {
 	return ios.GetAllMpathUtils().Get(mpath)
 }
 
+func (ios *ios) GetMpathWbps(mpath string) int64 {
+	cache := ios.refreshIostatCache()
+	if v, ok := cache.mpathWbps.Load(mpath); ok {
+		return v.(int64)
+	}
+	return 0
+}
+
 func (ios *ios) FillDiskStats(m AllDiskStats) {
 	debug.Assert(m != nil)
 	cache := ios.refreshIostatCache()
@@ -375,6 +385,15 @@ func (ios *ios) _refresh(config *cmn.Config) (ncache *cache, maxUtil int64, miss
 		}
 	}
 
+	// write throughput
+	for mpath, disks := range ios.mpath2disks {
+		var wbps int64
+		for d := range disks {
+			wbps += ncache.wbps[d]
+		}
+		ncache.mpathWbps.Store(mpath, wbps)
+	}
+
 	// average and max
 	if config.TestingEnv() {
 		for mpath, disks := range ios.mpath2disks {
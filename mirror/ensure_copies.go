@@ -0,0 +1,154 @@
+// Package mirror provides local mirroring and replica management
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package mirror
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/aistore/3rdparty/atomic"
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// max number of objects replicated in parallel by a given mountpath jogger
+// (all copies are additionally bounded target-wide - see config.Disk.MaxCopying)
+const ensureParallelCnt = 2
+
+type (
+	encFactory struct {
+		xreg.RenewBase
+		xctn *xactENC
+	}
+
+	// xactENC traverses all local mountpaths and (re)creates missing copies of the bucket's
+	// objects as per the current `mirror.copies` (see cluster.LOM.EnsureCopies).
+	// Unlike xactMNC, it never removes extra copies. Being idempotent, an aborted
+	// xaction can be simply restarted - objects that already have all their copies
+	// get skipped.
+	xactENC struct {
+		xact.BckJog
+		created atomic.Int64
+	}
+
+	// extended x-ensure-copies statistics
+	ExtEnsureCopiesStats struct {
+		Created int64 `json:"copies.created.n,string"`
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*xactENC)(nil)
+	_ xreg.Renewable = (*encFactory)(nil)
+)
+
+////////////////
+// encFactory //
+////////////////
+
+func (*encFactory) New(args xreg.Args, bck *cluster.Bck) xreg.Renewable {
+	p := &encFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	return p
+}
+
+func (p *encFactory) Start() error {
+	slab, err := p.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	cos.AssertNoErr(err)
+	p.xctn = newXactENC(p.Bck, p, slab)
+	return nil
+}
+
+func (*encFactory) Kind() string        { return apc.ActEnsureCopies }
+func (p *encFactory) Get() cluster.Xact { return p.xctn }
+
+func (p *encFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.WPR, err error) {
+	err = fmt.Errorf("%s is currently running, cannot start a new %q",
+		prevEntry.Get(), p.Str(p.Kind()))
+	return
+}
+
+/////////////
+// xactENC //
+/////////////
+
+func newXactENC(bck *cluster.Bck, p *encFactory, slab *memsys.Slab) (r *xactENC) {
+	r = &xactENC{}
+	mpopts := &mpather.JoggerGroupOpts{
+		T:        p.T,
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		Slab:     slab,
+		Parallel: ensureParallelCnt,
+		DoLoad:   mpather.Load, // to skip copies
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(p.UUID(), apc.ActEnsureCopies, bck, mpopts)
+	return
+}
+
+func (r *xactENC) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	mirror := r.Bck().Props.Mirror
+	if !mirror.Enabled {
+		glog.Warningf("%s: mirroring is disabled - nothing to do", r.Name())
+		r.Finish(nil)
+		return
+	}
+	if err := fs.ValidateNCopies(r.Target().String(), int(mirror.Copies)); err != nil {
+		r.Finish(err)
+		return
+	}
+	r.BckJog.Run()
+	glog.Infoln(r.Name())
+	err := r.BckJog.Wait()
+	r.Finish(err)
+}
+
+func (r *xactENC) visitObj(lom *cluster.LOM, buf []byte) error {
+	if !lom.IsHRW() {
+		return nil // misplaced: rebalance and resilver will take care of it
+	}
+	created, err := lom.EnsureCopies(buf)
+	switch {
+	case err == nil:
+		if created > 0 {
+			r.created.Add(int64(created))
+		}
+	case cmn.IsErrObjNought(err):
+		return nil // deleted in the meantime
+	case cos.IsErrOOS(err):
+		return cmn.NewErrAborted(r.Name(), "visit-obj", err)
+	default:
+		glog.Errorf("%s: %v", r.Name(), err)
+	}
+	r.ObjsAdd(1, lom.SizeBytes(true))
+	if r.Objs()%100 == 0 {
+		if cs := fs.GetCapStatus(); cs.Err != nil {
+			return cmn.NewErrAborted(r.Name(), "visit-obj", cs.Err)
+		}
+	}
+	return nil
+}
+
+func (r *xactENC) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.Ext = &ExtEnsureCopiesStats{Created: r.created.Load()}
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -13,5 +13,7 @@ func Init() {
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActCopyBck})
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActETLBck})
 	xreg.RegBckXact(&mncFactory{})
+	xreg.RegBckXact(&encFactory{})
+	xreg.RegBckXact(&rbcFactory{})
 	xreg.RegBckXact(&putFactory{})
 }
@@ -0,0 +1,139 @@
+// Package mirror provides local mirroring and replica management
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package mirror
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/aistore/3rdparty/atomic"
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+type (
+	rbcFactory struct {
+		xreg.RenewBase
+		xctn *xactRBC
+	}
+
+	// xactRBC traverses all local mountpaths and relocates copies of the bucket's objects
+	// from the mountpaths that hold disproportionately many copies to the ones that hold
+	// the fewest (see cluster.LOM.RebalanceCopies). The number of copies never changes -
+	// compare with xactENC (under-replication) and xactMNC (changing `mirror.copies`).
+	// Same as xactENC, an aborted xaction can be simply restarted.
+	xactRBC struct {
+		xact.BckJog
+		moved atomic.Int64
+	}
+
+	// extended x-rebalance-copies statistics
+	ExtRebalanceCopiesStats struct {
+		Moved int64 `json:"copies.moved.n,string"`
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*xactRBC)(nil)
+	_ xreg.Renewable = (*rbcFactory)(nil)
+)
+
+////////////////
+// rbcFactory //
+////////////////
+
+func (*rbcFactory) New(args xreg.Args, bck *cluster.Bck) xreg.Renewable {
+	p := &rbcFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	return p
+}
+
+func (p *rbcFactory) Start() error {
+	slab, err := p.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	cos.AssertNoErr(err)
+	p.xctn = newXactRBC(p.Bck, p, slab)
+	return nil
+}
+
+func (*rbcFactory) Kind() string        { return apc.ActRebalanceCopies }
+func (p *rbcFactory) Get() cluster.Xact { return p.xctn }
+
+func (p *rbcFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.WPR, err error) {
+	err = fmt.Errorf("%s is currently running, cannot start a new %q",
+		prevEntry.Get(), p.Str(p.Kind()))
+	return
+}
+
+/////////////
+// xactRBC //
+/////////////
+
+func newXactRBC(bck *cluster.Bck, p *rbcFactory, slab *memsys.Slab) (r *xactRBC) {
+	r = &xactRBC{}
+	mpopts := &mpather.JoggerGroupOpts{
+		T:        p.T,
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		Slab:     slab,
+		DoLoad:   mpather.Load, // to skip copies
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(p.UUID(), apc.ActRebalanceCopies, bck, mpopts)
+	return
+}
+
+func (r *xactRBC) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	if !r.Bck().Props.Mirror.Enabled {
+		glog.Warningf("%s: mirroring is disabled - nothing to do", r.Name())
+		r.Finish(nil)
+		return
+	}
+	r.BckJog.Run()
+	glog.Infoln(r.Name())
+	err := r.BckJog.Wait()
+	r.Finish(err)
+}
+
+func (r *xactRBC) visitObj(lom *cluster.LOM, buf []byte) error {
+	if !lom.IsHRW() || !lom.HasCopies() {
+		return nil
+	}
+	moved, err := lom.RebalanceCopies(buf)
+	switch {
+	case err == nil:
+		if moved > 0 {
+			r.moved.Add(int64(moved))
+		}
+	case cmn.IsErrObjNought(err):
+		return nil // deleted in the meantime
+	case cos.IsErrOOS(err):
+		return cmn.NewErrAborted(r.Name(), "visit-obj", err)
+	default:
+		glog.Errorf("%s: %v", r.Name(), err)
+	}
+	r.ObjsAdd(1, lom.SizeBytes(true))
+	return nil
+}
+
+func (r *xactRBC) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.Ext = &ExtRebalanceCopiesStats{Moved: r.moved.Load()}
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -29,7 +29,7 @@ func delCopies(lom *cluster.LOM, copies int) (size int64, err error) {
 
 	copiesFQN := make([]string, 0, ndel)
 	for copyFQN := range lom.GetCopies() {
-		if copyFQN == lom.FQN {
+		if copyFQN == lom.FQN || lom.IsPinned(copyFQN) {
 			continue
 		}
 		copiesFQN = append(copiesFQN, copyFQN)
@@ -126,6 +126,30 @@ var _ = Describe("Mirror", func() {
 			Expect(copyLOM.HasCopies()).To(BeTrue())
 		})
 	})
+
+	Describe("delCopies", func() {
+		It("should not remove pinned copies", func() {
+			createTestFile(bucketPath, testObjectName, testObjectSize)
+			lom := newBasicLom(defaultObjFQN)
+			Expect(lom.IsHRW()).To(BeTrue())
+			lom.SetSize(testObjectSize)
+			lom.SetAtimeUnix(time.Now().UnixNano())
+			Expect(lom.Persist()).NotTo(HaveOccurred())
+
+			lom.Lock(true)
+			_, err := lom.Copy2FQN(expectedCopyFQN, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(lom.PinCopy(expectedCopyFQN)).NotTo(HaveOccurred())
+			Expect(lom.Persist()).NotTo(HaveOccurred())
+			lom.Unlock(true)
+
+			size, err := delCopies(lom, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(size).To(BeZero())
+			Expect(expectedCopyFQN).To(BeARegularFile())
+			Expect(lom.NumCopies()).To(Equal(2))
+		})
+	})
 })
 
 func createTestFile(filePath, objName string, size int64) {
@@ -519,7 +519,7 @@ func (reb *Reb) rebWaitAck(rargs *rebArgs) (errCnt int) {
 		xreb   = reb.xctn()
 	)
 	maxwt += time.Duration(int64(time.Minute) * int64(rargs.smap.CountTargets()/10))
-	maxwt = cos.MinDuration(maxwt, rargs.config.Rebalance.DestRetryTime.D()*2)
+	maxwt = cos.MinDuration(maxwt, rargs.config.Rebalance.DestRetryTime.Mul(2).D())
 	reb.changeStage(rebStageWaitAck)
 
 	for {
@@ -172,6 +172,7 @@ func RunCleanup(ini *IniCln) fs.CapStatus {
 	for _, j := range joggers {
 		j.stop()
 	}
+	parent.rmOrphans()
 	xcln.Finish(nil)
 	parent.cs.c, _ = fs.RefreshCapStatus(nil, nil)
 	if parent.cs.c.Err != nil {
@@ -195,6 +196,57 @@ func (p *clnP) rmMisplaced() (yes bool) {
 	return
 }
 
+// reclaim orphaned copies that previously failed to be removed (see cluster.QueueOrphan);
+// those that are still busy (or fail again) get requeued for the next run
+func (p *clnP) rmOrphans() {
+	var (
+		fevicted, bevicted int64
+		xcln               = p.ini.Xaction
+		orphans            = cluster.TakeOrphans()
+	)
+	for _, orphan := range orphans {
+		if !p.inScope(orphan.FQN) || xcln.IsAborted() {
+			cluster.QueueOrphan(orphan.FQN)
+			continue
+		}
+		size, requeue := cluster.ReclaimOrphan(orphan)
+		if requeue {
+			cluster.QueueOrphan(orphan.FQN)
+		} else if size > 0 {
+			fevicted++
+			bevicted += size
+		}
+	}
+	if len(orphans) == 0 {
+		return
+	}
+	if fevicted > 0 {
+		p.ini.StatsT.Add(stats.CleanupStoreSize, bevicted)
+		p.ini.StatsT.Add(stats.CleanupStoreCount, fevicted)
+		xcln.ObjsAdd(int(fevicted), bevicted)
+	}
+	queued, dropped := cluster.NumOrphans()
+	glog.Infof("%s: reclaimed %d orphaned copies (%s), remaining %d, dropped %d",
+		xcln, fevicted, cos.B2S(bevicted, 1), queued, dropped)
+}
+
+// when cleaning up specific buckets, leave the rest of orphans for later
+func (p *clnP) inScope(fqn string) bool {
+	if len(p.ini.Buckets) == 0 {
+		return true
+	}
+	parsed, err := fs.ParseFQN(fqn)
+	if err != nil {
+		return true // (ReclaimOrphan to handle)
+	}
+	for i := range p.ini.Buckets {
+		if parsed.Bck.Equal(&p.ini.Buckets[i]) {
+			return true
+		}
+	}
+	return false
+}
+
 //////////////////////
 // mountpath jogger //
 //////////////////////
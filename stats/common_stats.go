@@ -412,6 +412,10 @@ func (s *CoreStats) doAdd(name, nameSuffix string, val int64) {
 		v.cumulative += val
 		v.Value += val
 		v.Unlock()
+	case KindGauge:
+		v.Lock()
+		v.Value += val // (up and down)
+		v.Unlock()
 	case KindCounter:
 		v.Lock()
 		v.Value += val
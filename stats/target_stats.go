@@ -48,11 +48,15 @@ const (
 	StreamsInObjCount  = transport.InObjCount
 	StreamsInObjSize   = transport.InObjSize
 
+	// restored from any source: local copies, neighbors (GFN), EC (see RestoreInflight)
+	RestoreCount = "restore.n"
+
 	// errors
 	ErrCksumCount    = "err.cksum.n"
 	ErrCksumSize     = "err.cksum.size"
 	ErrMetadataCount = "err.md.n"
 	ErrIOCount       = "err.io.n"
+	ErrRestoreCount  = "err.restore.n"
 	// special
 	RestartCount = "restart.n"
 
@@ -62,6 +66,7 @@ const (
 	GetRedirLatency = "get.redir.ns"
 	PutRedirLatency = "put.redir.ns"
 	DownloadLatency = "dl.ns"
+	LcopyWaitTime   = "lcopy.wait.ns" // average time local copies wait to start (see cluster.CopyStats)
 
 	// DSort
 	DSortCreationReqCount    = "dsort.creation.req.n"
@@ -74,6 +79,15 @@ const (
 
 	// KindThroughput
 	GetThroughput = "get.bps" // bytes per second
+
+	// KindGauge: local (disk-to-disk) copies in progress (see cluster.CopyStats)
+	LcopyInflight = "lcopy.inflight"
+
+	// KindGauge: local copies pending lazy checksum verification (see mirror.lazy_cksum)
+	LcopyCksumPending = "lcopy.cksum.pending"
+
+	// KindGauge: objects currently being restored (incremented and decremented via Tracker.Add)
+	RestoreInflight = "restore.inflight"
 )
 
 type (
@@ -86,6 +100,11 @@ type (
 		lines       []string
 		mem         sys.MemStat
 		standby     bool
+		// previous cluster.CopyStats (to compute LcopyWaitTime)
+		lcopy struct {
+			started int64
+			wait    int64
+		}
 	}
 )
 
@@ -183,12 +202,19 @@ func (r *Trunner) RegMetrics(node *cluster.Snode) {
 
 	r.reg(GetThroughput, KindThroughput)
 
+	r.reg(LcopyInflight, KindGauge)
+	r.reg(LcopyCksumPending, KindGauge)
+	r.reg(LcopyWaitTime, KindLatency)
+	r.reg(RestoreInflight, KindGauge)
+	r.reg(RestoreCount, KindCounter)
+
 	// errors
 	r.reg(ErrCksumCount, KindCounter)
 	r.reg(ErrCksumSize, KindCounter)
 	r.reg(ErrMetadataCount, KindCounter)
 
 	r.reg(ErrIOCount, KindCounter)
+	r.reg(ErrRestoreCount, KindCounter)
 
 	// streams
 	r.reg(StreamsOutObjCount, KindCounter)
@@ -237,6 +263,18 @@ func (r *Trunner) log(now int64, uptime time.Duration, config *cmn.Config) {
 		v = s.Tracker[nameUtil(disk)]
 		v.Value = stats.Util
 	}
+	inflight, started, wait := cluster.CopyStats()
+	s.Tracker[LcopyInflight].Value = inflight
+	s.Tracker[LcopyCksumPending].Value, _ = cluster.LazyCksumStats()
+	if n := started - r.lcopy.started; n > 0 {
+		// (average over the copies started since the previous time)
+		v := s.Tracker[LcopyWaitTime]
+		v.Lock()
+		v.Value += int64(wait) - r.lcopy.wait
+		v.numSamples += n
+		v.Unlock()
+	}
+	r.lcopy.started, r.lcopy.wait = started, int64(wait)
 
 	// 2 copy stats, reset latencies, send via StatsD if configured
 	r.Core.updateUptime(uptime)
@@ -20,7 +20,22 @@ import (
 	"github.com/NVIDIA/aistore/ext/dsort/extract"
 )
 
+const (
+	tarBlockSize = 512 // (see archive/tar)
+	rndChunkSize = 32 * cos.KiB
+)
+
+// intra-archive ordering of record names (see CreateTarWithOrderedFiles)
+const (
+	NameOrderRandom  NameOrder = iota // random numeric names (the default and the only option for CreateTarWithRandomFiles)
+	NameOrderAsc                      // sequential zero-padded names, lexically ascending
+	NameOrderDesc                     // sequential zero-padded names, lexically descending
+	NameOrderShuffle                  // sequential zero-padded names, randomly permuted
+)
+
 type (
+	NameOrder int
+
 	FileContent struct {
 		Name    string
 		Ext     string
@@ -30,8 +45,31 @@ type (
 		name string
 		size int64
 	}
+	// describes a single record (file) written by CreateTarWithRandomFilesManifest;
+	// Offset is the offset of the record's (tar) header in the uncompressed tar stream
+	RecordInfo struct {
+		Name   string
+		Size   int64
+		Offset int64
+	}
+	// counts bytes written to the underlying writer (tar offsets)
+	cntWriter struct {
+		w io.Writer
+		n int64
+	}
 )
 
+func (cw *cntWriter) Write(p []byte) (n int, err error) {
+	n, err = cw.w.Write(p)
+	cw.n += int64(n)
+	return
+}
+
+// tar writer pads the previous record only when writing the next header
+func (cw *cntWriter) hdrOffset() int64 {
+	return (cw.n + tarBlockSize - 1) / tarBlockSize * tarBlockSize
+}
+
 func newDummyFile(name string, size int64) *dummyFile {
 	return &dummyFile{
 		name: name,
@@ -48,16 +86,14 @@ func (*dummyFile) Sys() any           { return nil }
 
 // adds a given buf to a tar or tar.gz or fills-out random fileSize bytes and adds anyway
 func addBufferToTar(tw *tar.Writer, path string, fileSize int, buf []byte) (err error) {
-	var b bytes.Buffer
 	if buf == nil {
-		buf = make([]byte, fileSize)
+		pb := allocBuf(fileSize)
+		defer freeBuf(pb)
+		buf = *pb
 		if _, err = rand.Read(buf); err != nil {
 			return
 		}
 	}
-	if _, err = b.Write(buf); err != nil {
-		return
-	}
 	header := new(tar.Header)
 	header.Name = path
 	header.Size = int64(fileSize)
@@ -66,17 +102,18 @@ func addBufferToTar(tw *tar.Writer, path string, fileSize int, buf []byte) (err
 	if err = tw.WriteHeader(header); err != nil {
 		return
 	}
-	// copy buffer to the tarball
-	_, err = io.CopyBuffer(tw, &b, buf)
+	_, err = tw.Write(buf)
 	return
 }
 
 // adds random-filled fileSize to a zip
 func addRndToZip(tw *zip.Writer, path string, fileSize int) (err error) {
 	var (
-		w io.Writer
-		b = make([]byte, fileSize)
+		w  io.Writer
+		pb = allocBuf(fileSize)
+		b  = *pb
 	)
+	defer freeBuf(pb)
 	if _, err = rand.Read(b); err != nil {
 		return
 	}
@@ -95,9 +132,68 @@ func addRndToZip(tw *zip.Writer, path string, fileSize int) (err error) {
 // CreateTarWithRandomFiles creates tar with specified number of files. Tar is also gzipped if necessary.
 func CreateTarWithRandomFiles(tarName string, fileCnt, fileSize int, duplication bool,
 	recordExts []string, randomNames []string) error {
+	return createTar(tarName, fileCnt, fileSize, duplication, recordExts, randomNames, nil)
+}
+
+// same as above but also returns the manifest: names, sizes, and offsets of all the records
+// in the order of their appearance in the tar
+func CreateTarWithRandomFilesManifest(tarName string, fileCnt, fileSize int, duplication bool,
+	recordExts []string, randomNames []string) ([]RecordInfo, error) {
+	manifest := make([]RecordInfo, 0, fileCnt*cos.Max(len(recordExts), 1))
+	err := createTar(tarName, fileCnt, fileSize, duplication, recordExts, randomNames, &manifest)
+	return manifest, err
+}
+
+// CreateTarWithOrderedFiles creates tar with `fileCnt` records (times the number of `recordExts`)
+// named `prefix` + (random or sequential) number + extension, in the specified order;
+// non-zero `seed` makes both random names (NameOrderRandom) and permutations (NameOrderShuffle)
+// reproducible. Prefix, if specified, must be distinct for distinct tars, to avoid duplicate
+// records across (dsort) input shards.
+func CreateTarWithOrderedFiles(tarName string, fileCnt, fileSize int, recordExts []string,
+	prefix string, order NameOrder, seed int64) error {
+	if len(recordExts) == 0 {
+		recordExts = []string{".txt"}
+	}
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	var (
+		rnd    = rand.New(rand.NewSource(seed))
+		digits = len(strconv.Itoa(cos.Max(fileCnt-1, 0)))
+		bases  = make([]string, fileCnt)
+		names  = make([]string, 0, fileCnt*len(recordExts))
+	)
+	for i := 0; i < fileCnt; i++ {
+		switch order {
+		case NameOrderRandom:
+			bases[i] = prefix + strconv.Itoa(rnd.Int())
+		case NameOrderAsc, NameOrderShuffle:
+			bases[i] = fmt.Sprintf("%s%0*d", prefix, digits, i)
+		case NameOrderDesc:
+			bases[i] = fmt.Sprintf("%s%0*d", prefix, digits, fileCnt-1-i)
+		default:
+			return fmt.Errorf("invalid name order %d", order)
+		}
+	}
+	if order == NameOrderShuffle {
+		rnd.Shuffle(fileCnt, func(i, j int) { bases[i], bases[j] = bases[j], bases[i] })
+	}
+	// records (ie., same base name, different extensions) must remain adjacent
+	for _, base := range bases {
+		for _, ext := range recordExts {
+			names = append(names, base+ext)
+		}
+	}
+	return createTar(tarName, len(names), fileSize, false, nil, names, nil)
+}
+
+func createTar(tarName string, fileCnt, fileSize int, duplication bool,
+	recordExts []string, randomNames []string, manifest *[]RecordInfo) error {
 	var (
 		gzw     *gzip.Writer
 		tw      *tar.Writer
+		cw      *cntWriter
+		w       io.Writer
 		gzipped = cos.IsGzipped(tarName)
 	)
 
@@ -108,14 +204,18 @@ func CreateTarWithRandomFiles(tarName string, fileCnt, fileSize int, duplication
 	}
 	defer tarball.Close()
 
+	w = tarball
 	if gzipped {
 		// set up the gzip writer
 		gzw = gzip.NewWriter(tarball)
 		defer gzw.Close()
-		tw = tar.NewWriter(gzw)
-	} else {
-		tw = tar.NewWriter(tarball)
+		w = gzw
 	}
+	if manifest != nil {
+		cw = &cntWriter{w: w}
+		w = cw
+	}
+	tw = tar.NewWriter(w)
 	defer tw.Close()
 
 	prevFileName := ""
@@ -140,6 +240,9 @@ func CreateTarWithRandomFiles(tarName string, fileCnt, fileSize int, duplication
 			} else {
 				fileName = randomNames[i]
 			}
+			if manifest != nil {
+				*manifest = append(*manifest, RecordInfo{Name: fileName, Size: int64(fileSize), Offset: cw.hdrOffset()})
+			}
 			if err := addBufferToTar(tw, fileName, fileSize, nil); err != nil {
 				return err
 			}
@@ -181,6 +284,65 @@ func CreateTarWithCustomFilesToWriter(w io.Writer, fileCnt, fileSize int, custom
 	return nil
 }
 
+// CreateTarToWriter writes a tar (gzipped if `compressed`) of `end - start` records named
+// by their zero-padded (to `digits`) indices, each `size` bytes of random content;
+// returns the total (logical) size of all records.
+// Content is generated in small chunks, so that the memory needed to generate the archive
+// does not depend on the record size.
+func CreateTarToWriter(w io.Writer, start, end, size, digits int, compressed bool) (total int, err error) {
+	var (
+		gzw   *gzip.Writer
+		chunk = make([]byte, cos.Min(size, rndChunkSize))
+	)
+	if _, err = rand.Read(chunk); err != nil {
+		return
+	}
+	if compressed {
+		gzw = gzip.NewWriter(w)
+		w = gzw
+	}
+	tw := tar.NewWriter(w)
+	for i := start; i < end; i++ {
+		name := fmt.Sprintf("%0*d.txt", digits, i)
+		if err = addChunksToTar(tw, name, size, chunk); err != nil {
+			return
+		}
+		total += size
+	}
+	if err = tw.Close(); err != nil {
+		return
+	}
+	if gzw != nil {
+		err = gzw.Close()
+	}
+	return
+}
+
+// same as above, in memory: returns the archive's bytes
+func CreateTarBytes(start, end, size, digits int, compressed bool) ([]byte, int, error) {
+	var b bytes.Buffer
+	if !compressed {
+		b.Grow(cos.Max(end-start, 0) * (size + 2*tarBlockSize))
+	}
+	total, err := CreateTarToWriter(&b, start, end, size, digits, compressed)
+	if err != nil {
+		return nil, 0, err
+	}
+	return b.Bytes(), total, nil
+}
+
+// adds fileSize bytes to a tar by (repeatedly) writing the same random chunk
+func addChunksToTar(tw *tar.Writer, path string, fileSize int, chunk []byte) (err error) {
+	header := &tar.Header{Name: path, Size: int64(fileSize), Typeflag: tar.TypeReg}
+	if err = tw.WriteHeader(header); err != nil {
+		return
+	}
+	for n := fileSize; n > 0 && err == nil; n -= len(chunk) {
+		_, err = tw.Write(chunk[:cos.Min(n, len(chunk))])
+	}
+	return
+}
+
 func CreateTarWithCustomFiles(tarName string, fileCnt, fileSize int, customFileType, customFileExt string, missingKeys bool) error {
 	// set up the output file
 	tarball, err := cos.CreateFile(tarName)
@@ -0,0 +1,225 @@
+// Package archive provides common low-level utilities for testing archives
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package archive_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/archive"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// many archives of different formats and record sizes, generated in parallel
+func TestCreateConcurrent(t *testing.T) {
+	const (
+		numArchs = 64
+		fileCnt  = 20
+	)
+	var (
+		dir   = t.TempDir()
+		sizes = []int{0, 1, 100, cos.KiB, 4*cos.KiB + 1, 100 * cos.KiB}
+		exts  = []string{".tar", ".tar.gz", ".zip"}
+		wg    sync.WaitGroup
+		errCh = make(chan error, numArchs)
+	)
+	for i := 0; i < numArchs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var (
+				ext      = exts[i%len(exts)]
+				fileSize = sizes[i%len(sizes)]
+				name     = filepath.Join(dir, fmt.Sprintf("arch-%d%s", i, ext))
+				err      error
+			)
+			if ext == ".zip" {
+				err = archive.CreateZipWithRandomFiles(name, fileCnt, fileSize, nil)
+			} else {
+				err = archive.CreateTarWithRandomFiles(name, fileCnt, fileSize, false, nil, nil)
+			}
+			if err == nil {
+				err = validate(name, fileCnt, fileSize)
+			}
+			errCh <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		tassert.CheckError(t, err)
+	}
+}
+
+// manifest must list all the records, in order, with their sizes and (tar header) offsets
+func TestCreateManifest(t *testing.T) {
+	const fileCnt = 17
+	var (
+		dir  = t.TempDir()
+		exts = []string{".txt", ".jpg"}
+	)
+	for _, ext := range []string{".tar", ".tar.gz"} {
+		for _, fileSize := range []int{0, 1, 511, 512, cos.KiB + 3} {
+			name := filepath.Join(dir, fmt.Sprintf("arch-%d%s", fileSize, ext))
+			manifest, err := archive.CreateTarWithRandomFilesManifest(name, fileCnt, fileSize, false, exts, nil)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, len(manifest) == fileCnt*len(exts), "%s: expected %d manifest entries, got %d",
+				name, fileCnt*len(exts), len(manifest))
+
+			b := readTar(t, name)
+			tr := tar.NewReader(bytes.NewReader(b))
+			for i := 0; ; i++ {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					tassert.Errorf(t, i == len(manifest), "%s: expected %d records, got %d", name, len(manifest), i)
+					break
+				}
+				tassert.CheckFatal(t, err)
+				tassert.Fatalf(t, i < len(manifest), "%s: record %q not in manifest", name, hdr.Name)
+				rec := manifest[i]
+				tassert.Errorf(t, rec.Name == hdr.Name && rec.Size == hdr.Size, "%s: record #%d: manifest %+v vs (%q, %d)",
+					name, i, rec, hdr.Name, hdr.Size)
+
+				// reading from the manifested offset must yield the very same record
+				tassert.Fatalf(t, rec.Offset >= 0 && rec.Offset < int64(len(b)), "%s: %+v: invalid offset", name, rec)
+				h, err := tar.NewReader(bytes.NewReader(b[rec.Offset:])).Next()
+				tassert.CheckFatal(t, err)
+				tassert.Errorf(t, h.Name == rec.Name, "%s: offset %d: expected %q, got %q", name, rec.Offset, rec.Name, h.Name)
+			}
+		}
+	}
+}
+
+// in-memory (and optionally gzipped) tar must read back as the requested sequence of records
+func TestCreateTarBytes(t *testing.T) {
+	const (
+		start, end = 7, 120
+		digits     = 4
+	)
+	for _, compressed := range []bool{false, true} {
+		for _, size := range []int{0, 1, 100, 32*cos.KiB + 1, 100 * cos.KiB} {
+			b, total, err := archive.CreateTarBytes(start, end, size, digits, compressed)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, total == (end-start)*size, "expected total %d, got %d", (end-start)*size, total)
+
+			var r io.Reader = bytes.NewReader(b)
+			if compressed {
+				gzr, err := gzip.NewReader(r)
+				tassert.CheckFatal(t, err)
+				r = gzr
+			}
+			var (
+				tr = tar.NewReader(r)
+				i  = start
+			)
+			for ; ; i++ {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				tassert.CheckFatal(t, err)
+				expected := fmt.Sprintf("%0*d.txt", digits, i)
+				tassert.Errorf(t, hdr.Name == expected, "expected record %q, got %q", expected, hdr.Name)
+				n, err := io.Copy(io.Discard, tr)
+				tassert.CheckFatal(t, err)
+				tassert.Errorf(t, n == int64(size) && hdr.Size == int64(size), "%s: expected size %d, got %d (hdr %d)",
+					hdr.Name, size, n, hdr.Size)
+			}
+			tassert.Errorf(t, i == end, "(compressed=%t, size=%d): expected %d records, got %d",
+				compressed, size, end-start, i-start)
+		}
+	}
+}
+
+// returns the entire uncompressed tar
+func readTar(t *testing.T, name string) []byte {
+	b, err := os.ReadFile(name)
+	tassert.CheckFatal(t, err)
+	if !cos.IsGzipped(name) {
+		return b
+	}
+	gzr, err := gzip.NewReader(bytes.NewReader(b))
+	tassert.CheckFatal(t, err)
+	defer gzr.Close()
+	b, err = io.ReadAll(gzr)
+	tassert.CheckFatal(t, err)
+	return b
+}
+
+func validate(name string, fileCnt, fileSize int) error {
+	var cnt int
+	if filepath.Ext(name) == ".zip" {
+		zr, err := zip.OpenReader(name)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		for _, f := range zr.File {
+			if err := checkRecord(name, f.Name, int64(fileSize), f.Open); err != nil {
+				return err
+			}
+			cnt++
+		}
+	} else {
+		fh, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		var r io.Reader = fh
+		if cos.IsGzipped(name) {
+			gzr, err := gzip.NewReader(fh)
+			if err != nil {
+				return err
+			}
+			defer gzr.Close()
+			r = gzr
+		}
+		tr := tar.NewReader(r)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %v", name, err)
+			}
+			open := func() (io.ReadCloser, error) { return io.NopCloser(tr), nil }
+			if err := checkRecord(name, hdr.Name, int64(fileSize), open); err != nil {
+				return err
+			}
+			cnt++
+		}
+	}
+	if cnt != fileCnt {
+		return fmt.Errorf("%s: expected %d records, got %d", name, fileCnt, cnt)
+	}
+	return nil
+}
+
+func checkRecord(name, recName string, size int64, open func() (io.ReadCloser, error)) error {
+	rc, err := open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	n, err := io.Copy(io.Discard, rc)
+	if err != nil {
+		return fmt.Errorf("%s/%s: %v", name, recName, err)
+	}
+	if n != size {
+		return fmt.Errorf("%s/%s: expected size %d, got %d", name, recName, size, n)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Package archive provides common low-level utilities for testing archives
+/*
+ * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package archive
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// Record buffers are pooled by size class (powers of two, from minBufSize to maxBufSize),
+// so that any number of archives can be generated concurrently, each drawing
+// appropriately-sized buffers and without any global locking.
+// Larger records are allocated (and garbage-collected) on demand.
+
+const (
+	minBufShift = 12 // 4KiB
+	maxBufShift = 26 // 64MiB
+	minBufSize  = 1 << minBufShift
+	maxBufSize  = 1 << maxBufShift
+)
+
+var bufPools [maxBufShift - minBufShift + 1]sync.Pool
+
+// returns the size class or -1 if the size is out of pooled range
+func sizeClass(size int) int {
+	if size <= minBufSize {
+		return 0
+	}
+	if size > maxBufSize {
+		return -1
+	}
+	return bits.Len(uint(size-1)) - minBufShift
+}
+
+func allocBuf(size int) *[]byte {
+	c := sizeClass(size)
+	if c < 0 {
+		b := make([]byte, size)
+		return &b
+	}
+	if v := bufPools[c].Get(); v != nil {
+		b := v.(*[]byte)
+		*b = (*b)[:size]
+		return b
+	}
+	b := make([]byte, size, minBufSize<<c)
+	return &b
+}
+
+func freeBuf(b *[]byte) {
+	c := sizeClass(cap(*b))
+	if c < 0 || cap(*b) != minBufSize<<c {
+		return
+	}
+	bufPools[c].Put(b)
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"math"
 	"reflect"
+	"sort"
 	"time"
 	"unsafe"
 
@@ -32,6 +33,7 @@ import (
 const (
 	opcFin = iota + math.MaxUint16 - 16
 	opcIdleTick
+	opcCtrl // sideband control (see Stream.SendCtrl)
 )
 
 func ReservedOpcode(opc int) bool { return opc >= opcFin }
@@ -45,8 +47,6 @@ const (
 	maxSizeHeader  = memsys.MaxPageSlabSize
 )
 
-const sizeofh = int(unsafe.Sizeof(Obj{}))
-
 type (
 	// advanced usage: additional stream control
 	Extra struct {
@@ -58,26 +58,105 @@ type (
 		IdleTeardown time.Duration // when exceeded, causes PUT to terminate (and to renew upon the very next send)
 		SizePDU      int32         // NOTE: 0(zero): no PDUs; must be below maxSizePDU; unknown size _requires_ PDUs
 		MaxHdrSize   int32         // overrides `dfltMaxHdr` if specified
+		Codec        OpaqueCodec   // optional: encodes `ObjHdr.OpaqueV` when `ObjHdr.Opaque` is not set
+		CallerID     string        // optional: sender's node ID - to identify the peer on the receive side (see Peer)
+		Network      string        // optional: network of the destination URL (one of cmn.KnownNetworks); default: cmn.NetIntraData
+		Seq          bool          // optional: stamp each object header with per-session sequence number (see ObjHdr.Seq)
+	}
+	// advanced usage: additional receive-side control (compare with Extra above)
+	RxExtra struct {
+		RxProgress   RxProgressCB  // optional per-object progress callback
+		Skip         RxSkipCB      // optional: skip (ie., discard) the object based on its header alone
+		ProgressSize int64         // invoke RxProgress at most once per so many received bytes (default: dfltProgressSize)
+		ReadTimeout  time.Duration // optional: max time to wait on a single (in-object) read; exceeding it fails the stream
+		Codec        OpaqueCodec   // optional: decodes received `ObjHdr.Opaque` into `ObjHdr.OpaqueV`
+		OnEnd        RxEndCB       // optional: end-of-stream notification (see RxEndCB)
+		// optional: read from the network in chunks of (up to) so many bytes, regardless of the sizes
+		// of the callback's reads; zero (default) - unbuffered, as per the callback's reads
+		ReadSize int
+		// optional: run RecvObj on up to so many concurrent goroutines (per endpoint) rather than inline;
+		// the payload is then read into memory prior to the call (see "Asynchronous receive" in README)
+		Workers int
+		// optional: reject (and terminate) the stream upon receiving a header that declares
+		// object size greater than so many bytes or, for PDU-based and unsized objects, upon
+		// receiving the PDU that would exceed it; zero (default) - unlimited
+		MaxObjSize int64
+		// optional: sideband control frames (see RxCtrlCB); when not specified, control frames
+		// are silently discarded
+		OnControl RxCtrlCB
+		// optional: accept at most so many new streams per second per network (with bursts of up to
+		// the same number); excess streams get rejected with a retryable 503; zero (default) - unlimited
+		// (compare with config.Transport.MaxRxStreams that limits the number of active streams)
+		AcceptRate int
+		// optional: session accounting (see RxSessCloseCB)
+		OnSessionClose RxSessCloseCB
+		// optional: flow control - desired max send rate advertised back to the sender (see RxRateCB)
+		MaxRate RxRateCB
+		// disable accounting of header vs. payload receive time (see Stats.HdrTime); the
+		// overhead is two coarse monotonic clock reads per object or message
+		NoTiming bool
+		// optional: compute the checksum of each received object as the callback reads it - in the
+		// same single pass - to verify it against the one carried in the header (see Verified)
+		VerifyCksum bool
+		// optional: pool of payload read buffers (see RxBufPool); nil (default) - the callback
+		// reads into its own buffers
+		BufPool RxBufPool
+	}
+	// remote peer of the receiving stream (see GetPeer)
+	Peer struct {
+		Addr string // remote network address (as per http.Request.RemoteAddr)
+		ID   string // caller (node) ID, if provided by the sender (see Extra.CallerID)
 	}
 	EndpointStats map[uint64]*Stats // all stats for a given (network, trname) endpoint indexed by session ID
 
+	// receive-side state and stats of a given (network, trname) endpoint (see GetNetworkStats)
+	NetEndpointStats struct {
+		Sessions EndpointStats
+		// streams accepted so far, and the ones rejected due to RxExtra.AcceptRate
+		Accepted  int64
+		Throttled int64
+		// currently active and peak (max-ever) number of streams, and the number of streams
+		// rejected due to config.Transport.MaxRxStreams - the limit that applies to the sum
+		// of active streams of all endpoints on the same network
+		Active int64
+		Peak   int64
+		Busy   int64
+		Paused bool // not accepting new streams (see PauseHandler)
+	}
+
 	// object header
 	ObjHdr struct {
 		Bck      cmn.Bck
 		ObjName  string
 		SID      string       // sender node ID
 		Opaque   []byte       // custom control (optional)
+		OpaqueV  any          // typed custom control (optional; requires OpaqueCodec on both sides - never transmitted as is)
 		ObjAttrs cmn.ObjAttrs // attributes/metadata of the object that's being transmitted
 		Opcode   int          // (see reserved range above)
+		// per-session sequence number: 1, 2, 3, ... in the order of sending when the sender
+		// enables it (see Extra.Seq); otherwise, zero (and not transmitted)
+		Seq uint64
+		// optional: the payload is (already) gzip-compressed by the sender, and the receiver
+		// delivers it decompressed (see "Per-object gzip" in README); `ObjAttrs.Size` is then
+		// the compressed (on-wire) size, while `Usize` is the decompressed one (zero if unknown)
+		Usize int64
+		Gzip  bool
 	}
 	// object to transmit
 	Obj struct {
 		Reader   io.ReadCloser // reader (to read the object, and close when done)
 		CmplArg  any           // optional context passed to the ObjSentCB callback
 		Callback ObjSentCB     // called when the last byte is sent _or_ when the stream terminates (see term.reason)
+		Trailer  TrailerCB     // optional: send trailer (see ObjTrailer) upon sending the payload
 		prc      *atomic.Int64 // private; if present, ref-counts so that we call ObjSentCB only once
 		Hdr      ObjHdr
 	}
+	// optional end-of-object status sent after the object's payload (e.g., checksum computed on the fly);
+	// header-only objects are never followed by trailers
+	ObjTrailer struct {
+		Cksum  *cos.Cksum // (optional)
+		Status int64      // sender-defined; zero is expected to indicate success
+	}
 
 	// object-sent callback that has the following signature can optionally be defined on a:
 	// a) per-stream basis (via NewStream constructor - see Extra struct above)
@@ -99,8 +178,63 @@ type (
 	// Rx callbacks
 	RecvObj func(hdr ObjHdr, object io.Reader, err error) error
 	RecvMsg func(msg Msg, err error) error
+
+	// (optional) Rx progress: received bytes of the object that's currently being read,
+	// where total is the object size or SizeUnknown (see RxExtra above)
+	RxProgressCB func(hdr ObjHdr, received, total int64)
+
+	// (optional) called by the sending stream right after it has read the last byte of the
+	// object's payload (that is, `sent` bytes), to produce the object's trailer
+	TrailerCB func(hdr ObjHdr, sent int64) ObjTrailer
+
+	// (optional) typed opaque: (de)serializes a caller-defined value to/from `ObjHdr.Opaque`;
+	// registered per trname on the receive side (see RxExtra) and per stream on the send side (see Extra)
+	// NOTE: Decode must not retain `b` - the latter points into the transport's header buffer
+	OpaqueCodec interface {
+		Encode(v any) ([]byte, error)
+		Decode(b []byte) (any, error)
+	}
+
+	// (optional) caller-provided pool of payload read buffers (e.g., *memsys.MMSA): one buffer per
+	// object, acquired prior to calling RecvObj and returned to the pool upon its return; the callback
+	// gets it via GetReadBuf (to read the payload into, e.g., with io.CopyBuffer) and must not retain it
+	RxBufPool interface {
+		AllocSize(size int64) ([]byte, *memsys.Slab)
+		Free(buf []byte)
+	}
+
+	// (optional) when returns true, the transport discards the object's payload without
+	// calling RecvObj - e.g., when the receiver already has the object
+	RxSkipCB func(hdr ObjHdr) bool
+
+	// (optional) end-of-stream notification: called every time the receive loop exits, with:
+	// - nil error if the sender has cleanly terminated the stream (see Stream.Fin);
+	// - io.ErrUnexpectedEOF if the stream has ended without the last marker - e.g., aborted
+	//   by the sender or idle-torn-down, in which case the same session may resume later;
+	// - the actual error otherwise (including errors returned by RecvObj)
+	RxEndCB func(sessID int64, err error)
+
+	// (optional) sideband control: called upon receiving a control frame (see Stream.SendCtrl),
+	// in the stream's order relative to the objects; `opaque` is valid only for the duration of the call
+	RxCtrlCB func(sessID int64, opaque []byte)
+
+	// (optional) called with a snapshot of the session's (cumulative) stats when the session
+	// becomes idle (that is, upon every end of stream - the same session may resume later)
+	// and, again, when the idle session gets reaped (see sessionIsOld) or the endpoint
+	// unregistered - the latter being the final call for the session;
+	// the callback is invoked with no transport locks held but must not block
+	RxSessCloseCB func(trname string, sessID int64, final *Stats)
+
+	// (optional) flow control: called upon every end of stream (compare with RxSessCloseCB) to compute
+	// the desired max send rate (bytes per second) based on the current load - e.g., disk utilization
+	// and/or the session's stats; a positive rate is then advertised back to the sender via
+	// apc.HdrRxMaxRate response header, and cooperating senders pace their next session accordingly
+	// (see Stream, StreamWriter.SetMaxRate); zero means unlimited; must not block
+	RxRateCB func(trname string, sessID int64, stats *Stats) (bytesPerSec int64)
 )
 
+var _ RxBufPool = (*memsys.MMSA)(nil)
+
 ///////////////////
 // object stream //
 ///////////////////
@@ -114,6 +248,8 @@ func NewObjStream(client Client, dstURL, dstID string, extra *Extra) (s *Stream)
 	s = &Stream{streamBase: *newBase(client, dstURL, dstID, extra)}
 	s.streamBase.streamer = s
 	s.callback = extra.Callback
+	s.codec = extra.Codec
+	s.seqOn = extra.Seq
 	if extra.Compressed() {
 		s.initCompression(extra)
 	}
@@ -151,7 +287,17 @@ func NewObjStream(client Client, dstURL, dstID string, extra *Extra) (s *Stream)
 //     network errors that may cause sudden and instant termination of the underlying
 //     stream(s).
 func (s *Stream) Send(obj *Obj) (err error) {
-	debug.Assertf(len(obj.Hdr.Opaque) < len(s.maxhdr)-sizeofh, "(%d, %d)", len(obj.Hdr.Opaque), len(s.maxhdr))
+	if s.codec != nil && obj.Hdr.Opaque == nil && obj.Hdr.OpaqueV != nil {
+		if obj.Hdr.Opaque, err = s.codec.Encode(obj.Hdr.OpaqueV); err != nil {
+			s.doCmpl(obj, err)
+			return
+		}
+	}
+	if l := ObjHeaderSize(&obj.Hdr); l > len(s.maxhdr) {
+		err = fmt.Errorf("%s: %s header size %d exceeds the maximum %d", s, obj, l, len(s.maxhdr))
+		s.doCmpl(obj, err)
+		return
+	}
 
 	if err = s.startSend(obj); err != nil {
 		s.doCmpl(obj, err) // take a shortcut
@@ -169,7 +315,7 @@ func (s *Stream) Send(obj *Obj) (err error) {
 	})
 
 	s.workCh <- obj
-	if verbose {
+	if verbose.Load() {
 		glog.Infof("%s: send %s[sq=%d]", s, obj, len(s.workCh))
 	}
 	return
@@ -180,6 +326,20 @@ func (s *Stream) Fin() {
 	s.wg.Wait()
 }
 
+// SendCtrl sends a small control message (e.g., flow control update or checkpoint) that
+// the receiver gets via RxExtra.OnControl - in order relative to the objects sent via
+// the same stream but without going through the object callback (see RxCtrlCB).
+// Control frames are neither sequenced (see Extra.Seq) nor counted as objects, and
+// do not trigger send completions.
+func (s *Stream) SendCtrl(opaque []byte) error {
+	obj := AllocSend()
+	obj.Hdr.Opcode, obj.Hdr.Opaque = opcCtrl, opaque
+	obj.Callback = noopCtrlCB
+	return s.Send(obj)
+}
+
+func noopCtrlCB(ObjHdr, io.ReadCloser, any, error) {}
+
 ////////////////////
 // message stream //
 ////////////////////
@@ -205,7 +365,7 @@ func (s *MsgStream) Send(msg *Msg) (err error) {
 		return
 	}
 	s.workCh <- msg
-	if verbose {
+	if verbose.Load() {
 		glog.Infof("%s: send %s[sq=%d]", s, msg, len(s.workCh))
 	}
 	return
@@ -220,8 +380,17 @@ func (s *MsgStream) Fin() {
 // receive-side API //
 //////////////////////
 
-func HandleObjStream(trname string, rxObj RecvObj) error {
+func HandleObjStream(trname string, rxObj RecvObj, rxExtra ...*RxExtra) error {
 	h := &handler{trname: trname, rxObj: rxObj, hkName: ObjURLPath(trname)}
+	if len(rxExtra) > 0 && rxExtra[0] != nil {
+		h.extra = *rxExtra[0]
+		if h.extra.ProgressSize <= 0 {
+			h.extra.ProgressSize = dfltProgressSize
+		}
+		if h.extra.Workers > 0 {
+			h.wsema = cos.NewSemaphore(h.extra.Workers)
+		}
+	}
 	return h.handle()
 }
 
@@ -236,6 +405,7 @@ func Unhandle(trname string) (err error) {
 		delete(handlers, trname)
 		mu.Unlock()
 		hk.Unreg(h.hkName + hk.NameSuffix)
+		h.oldSessions.Range(h.reapAll)
 	} else {
 		mu.Unlock()
 		err = fmt.Errorf(cmn.FmtErrUnknown, "transport", "endpoint", trname)
@@ -243,6 +413,40 @@ func Unhandle(trname string) (err error) {
 	return
 }
 
+// PauseHandler stops accepting new streams for a given (network, trname) endpoint without
+// tearing down the handler: in-flight streams drain to completion while new ones get rejected
+// with a retryable 503 (see ErrRxBusy); the same trname on other networks is not affected;
+// ResumeHandler reverses the effect
+func PauseHandler(network, trname string) error  { return pause(network, trname, true) }
+func ResumeHandler(network, trname string) error { return pause(network, trname, false) }
+
+func pause(network, trname string, paused bool) error {
+	ep, err := rxEndpointOf(network, trname)
+	if err == nil {
+		ep.paused.Store(paused)
+	}
+	return err
+}
+
+func IsHandlerPaused(network, trname string) bool {
+	ep, err := rxEndpointOf(network, trname)
+	return err == nil && ep.paused.Load()
+}
+
+func rxEndpointOf(network, trname string) (*rxEndpoint, error) {
+	mu.RLock()
+	h, ok := handlers[trname]
+	mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf(cmn.FmtErrUnknown, "transport", "endpoint", trname)
+	}
+	ep, ok := h.eps[network]
+	if !ok {
+		return nil, fmt.Errorf(cmn.FmtErrUnknown, "transport", "network", network)
+	}
+	return ep, nil
+}
+
 ////////////////////
 // stats and misc //
 ////////////////////
@@ -257,19 +461,47 @@ func _urlPath(endp, trname string) string {
 	return cos.JoinWords(apc.Version, endp, trname)
 }
 
+// GetStats returns receive-side session stats of all endpoints (all networks), indexed by trname
+// (compare with GetNetworkStats)
 func GetStats() (netstats map[string]EndpointStats, err error) {
 	netstats = make(map[string]EndpointStats)
 	mu.Lock()
 	for trname, h := range handlers {
 		eps := make(EndpointStats)
 		f := func(key, value any) bool {
-			out := &Stats{}
-			uid := key.(uint64)
-			in := value.(*Stats)
-			out.Num.Store(in.Num.Load())
-			out.Offset.Store(in.Offset.Load())
-			out.Size.Store(in.Size.Load())
-			eps[uid] = out
+			eps[key.(uint64)] = value.(*Stats).snap()
+			return true
+		}
+		h.sessions.Range(f)
+		netstats[trname] = eps
+	}
+	mu.Unlock()
+	return
+}
+
+// GetNetworkStats returns receive-side state and stats of all (network, trname) endpoints
+// on a given network, indexed by trname
+func GetNetworkStats(network string) (netstats map[string]NetEndpointStats, err error) {
+	if !cmn.NetworkIsKnown(network) {
+		return nil, fmt.Errorf(cmn.FmtErrUnknown, "transport", "network", network)
+	}
+	netstats = make(map[string]NetEndpointStats)
+	mu.Lock()
+	for trname, h := range handlers {
+		ep := h.eps[network]
+		eps := NetEndpointStats{
+			Sessions:  make(EndpointStats),
+			Accepted:  ep.accepted.Load(),
+			Throttled: ep.throttled.Load(),
+			Active:    ep.active.Load(),
+			Peak:      ep.peak.Load(),
+			Busy:      ep.busy.Load(),
+			Paused:    ep.paused.Load(),
+		}
+		f := func(key, value any) bool {
+			if stats := value.(*Stats); stats.network == network {
+				eps.Sessions[key.(uint64)] = stats.snap()
+			}
 			return true
 		}
 		h.sessions.Range(f)
@@ -278,3 +510,41 @@ func GetStats() (netstats map[string]EndpointStats, err error) {
 	mu.Unlock()
 	return
 }
+
+// Endpoints returns the (URL path) endpoints that currently have registered handlers:
+// apc.ObjStream and/or apc.MsgStream. Note that registration is network-agnostic -
+// the same endpoints are served on all networks the node registers RxAnyStream with
+// (while pausing and stats are per network - see Extra.Network).
+func Endpoints() (endpoints []string) {
+	var haveObj, haveMsg bool
+	mu.RLock()
+	for _, h := range handlers {
+		if h.endpoint() == apc.ObjStream {
+			haveObj = true
+		} else {
+			haveMsg = true
+		}
+	}
+	mu.RUnlock()
+	if haveObj {
+		endpoints = append(endpoints, apc.ObjStream)
+	}
+	if haveMsg {
+		endpoints = append(endpoints, apc.MsgStream)
+	}
+	return
+}
+
+// Handlers returns (sorted) names of the handlers registered with a given endpoint
+// (see Endpoints above) or all registered handlers when the endpoint is empty
+func Handlers(endpoint string) (trnames []string) {
+	mu.RLock()
+	for trname, h := range handlers {
+		if endpoint == "" || h.endpoint() == endpoint {
+			trnames = append(trnames, trname)
+		}
+	}
+	mu.RUnlock()
+	sort.Strings(trnames)
+	return
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/memsys"
 )
 
@@ -35,6 +36,7 @@ const (
 	inHdr = iota + 1
 	inPDU
 	inData
+	inTrailer
 	inEOB
 )
 
@@ -47,7 +49,9 @@ const (
 	reasonStopped = "stopped"
 
 	connErrWait = time.Second // ECONNREFUSED | ECONNRESET | EPIPE
-	termErrWait = time.Second
+	// max consecutive retries when the receiver is busy (see ErrRxBusy)
+	maxBusyRetries = 8
+	termErrWait    = time.Second
 )
 
 type (
@@ -76,6 +80,8 @@ type (
 		trname   string        // http endpoint: (trname, dstURL, dstID)
 		dstURL   string
 		dstID    string
+		callerID string // (see Extra.CallerID)
+		network  string // (see Extra.Network)
 		lid      string // log prefix
 		maxhdr   []byte // header buf must be large enough to accommodate max-size for this stream
 		header   []byte // object header (slice of the maxhdr with bucket/objName, etc. fields packed/serialized)
@@ -97,6 +103,24 @@ type (
 		sessID  int64        // stream session ID
 		Numcur  int64        // gets reset to zero upon each timeout
 		Sizecur int64        // ditto
+		maxRate atomic.Int64 // max send rate (bytes/s) advertised by the receiver (see RxRateCB)
+		// the receiver does not limit (and does not pause) new streams - no need to pre-flight (see apc.HdrRxLimited)
+		rxUnlimited atomic.Bool
+	}
+
+	// flow control: paces reads (writes) so as not to exceed the receiver-advertised rate
+	pacer struct {
+		rate int64 // bytes per second
+		t0   int64 // mono time
+		n    int64 // bytes read (written) since t0
+	}
+	pacedReader struct {
+		r io.Reader
+		pacer
+	}
+	pacedWriter struct {
+		w io.Writer
+		pacer
 	}
 )
 
@@ -109,7 +133,10 @@ func newBase(client Client, dstURL, dstID string, extra *Extra) (s *streamBase)
 	u, err := url.Parse(dstURL)
 	cos.AssertNoErr(err)
 
-	s = &streamBase{client: client, dstURL: dstURL, dstID: dstID}
+	s = &streamBase{client: client, dstURL: dstURL, dstID: dstID, callerID: extra.CallerID, network: extra.Network}
+	if s.network == "" {
+		s.network = cmn.NetIntraData
+	}
 
 	s.sessID = nextSessionID.Inc()
 	s.trname = path.Base(u.Path)
@@ -172,7 +199,7 @@ func (s *streamBase) startSend(streamable fmt.Stringer) (err error) {
 
 	if s.sessST.CAS(inactive, active) {
 		s.postCh <- struct{}{}
-		if verbose {
+		if verbose.Load() {
 			glog.Infof("%s: inactive => active", s)
 		}
 	}
@@ -212,24 +239,53 @@ func (s *streamBase) GetStats() (stats Stats) {
 	return
 }
 
+// flow control: the rate advertised by the receiver upon the end of the previous session
+// (none - unlimited) applies to the next one
+func (s *streamBase) setMaxRate(val string) {
+	rate := parseMaxRate(val)
+	if prev := s.maxRate.Swap(rate); prev != rate && verbose.Load() {
+		glog.Infof("%s: max send rate %d => %d B/s", s, prev, rate)
+	}
+}
+
+// pre-flight only when the receiver may reject new streams: the first session (not knowing yet),
+// the receiver advertising its limits upon the previous request, or config.Transport.MaxRxStreams
+func (s *streamBase) needPreflight() bool {
+	return !s.rxUnlimited.Load() || cmn.GCO.Get().Transport.MaxRxStreams > 0
+}
+
+func (s *streamBase) setRxLimited(val string) { s.rxUnlimited.Store(val == "") }
+
+func (s *streamBase) paced(body io.Reader) io.Reader {
+	if rate := s.maxRate.Load(); rate > 0 {
+		return &pacedReader{r: body, pacer: pacer{rate: rate}}
+	}
+	return body
+}
+
 func (s *streamBase) isNextReq() (reason string) {
+	// end-of-stream takes precedence over (stale) post notifications
+	// that'd otherwise result in an empty request without the last marker (see RxEndCB)
+	if reason = s.isEnd(); reason != "" {
+		return
+	}
 	for {
 		select {
 		case <-s.lastCh.Listen():
-			if verbose {
+			if verbose.Load() {
 				glog.Infof("%s: end-of-stream", s)
 			}
 			reason = endOfStream
 			return
 		case <-s.stopCh.Listen():
-			if verbose {
+			if verbose.Load() {
 				glog.Infof("%s: stopped", s)
 			}
 			reason = reasonStopped
 			return
 		case <-s.postCh:
 			s.sessST.Store(active)
-			if verbose {
+			if verbose.Load() {
 				glog.Infof("%s: active <- posted", s)
 			}
 			return
@@ -237,9 +293,22 @@ func (s *streamBase) isNextReq() (reason string) {
 	}
 }
 
+// non-blocking check for end-of-stream
+func (s *streamBase) isEnd() (reason string) {
+	select {
+	case <-s.lastCh.Listen():
+		if verbose.Load() {
+			glog.Infof("%s: end-of-stream", s)
+		}
+		reason = endOfStream
+	default:
+	}
+	return
+}
+
 func (s *streamBase) deactivate() (n int, err error) {
 	err = io.EOF
-	if verbose {
+	if verbose.Load() {
 		num := s.stats.Num.Load()
 		glog.Infof("%s: connection teardown (%d/%d)", s, s.Numcur, num)
 	}
@@ -251,12 +320,23 @@ func (s *streamBase) sendLoop(dryrun bool) {
 		err     error
 		reason  string
 		retried bool
+		busy    int
 	)
 	for {
 		if s.sessST.Load() == active {
 			if dryrun {
 				s.streamer.dryrun()
 			} else if errR := s.streamer.doRequest(); errR != nil {
+				// receiver's over its limit: back off and retry (see config.Transport.MaxRxStreams)
+				if IsErrRxBusy(errR) && busy < maxBusyRetries {
+					busy++
+					glog.Warningf("%s: %v - backing off (%d/%d)", s, errR, busy, maxBusyRetries)
+					time.Sleep(connErrWait * time.Duration(busy))
+					if reason = s.isEnd(); reason != "" {
+						break
+					}
+					continue
+				}
 				if !cos.IsRetriableConnErr(err) || retried {
 					reason = reasonError
 					err = errR
@@ -266,6 +346,8 @@ func (s *streamBase) sendLoop(dryrun bool) {
 				retried = true
 				glog.Errorf("%s: %v - retrying...", s, errR)
 				time.Sleep(connErrWait)
+			} else {
+				busy = 0
 			}
 		}
 		if reason = s.isNextReq(); reason != "" {
@@ -303,6 +385,55 @@ func (extra *Extra) Compressed() bool {
 	return extra.Compression != "" && extra.Compression != apc.CompressNever
 }
 
+///////////
+// pacer //
+///////////
+
+// sleeps as needed to keep the average rate at or below the configured one;
+// idle periods do not accumulate credit beyond one second worth of bursting
+func (p *pacer) pace(n int) {
+	now := mono.NanoTime()
+	if p.t0 == 0 {
+		p.t0 = now
+	}
+	p.n += int64(n)
+	due := time.Duration(float64(p.n) / float64(p.rate) * float64(time.Second))
+	switch d := due - time.Duration(now-p.t0); {
+	case d > 0:
+		time.Sleep(d)
+	case d < -time.Second:
+		p.t0, p.n = now, 0
+	}
+}
+
+func (pr *pacedReader) Read(b []byte) (n int, err error) {
+	n, err = pr.r.Read(b)
+	if n > 0 {
+		pr.pace(n)
+	}
+	return
+}
+
+func (pw *pacedWriter) Write(b []byte) (n int, err error) {
+	n, err = pw.w.Write(b)
+	if n > 0 {
+		pw.pace(n)
+	}
+	return
+}
+
+// empty, invalid, or non-positive - unlimited
+func parseMaxRate(val string) int64 {
+	if val == "" {
+		return 0
+	}
+	rate, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || rate < 0 {
+		return 0
+	}
+	return rate
+}
+
 //
 // misc
 //
@@ -107,6 +107,10 @@ func NewStreams(sowner cluster.Sowner, lsnode *cluster.Snode, cl transport.Clien
 	if sb.extra.Config == nil {
 		sb.extra.Config = cmn.GCO.Get()
 	}
+	if sb.extra.CallerID == "" {
+		sb.extra.CallerID = lsnode.ID()
+	}
+	sb.extra.Network = sb.network
 	if !sb.extra.Compressed() {
 		sb.lid = fmt.Sprintf("sb[%s-%s-%s]", sb.lsnode.ID(), sb.network, sb.trname)
 	} else {
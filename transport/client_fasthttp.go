@@ -58,27 +58,68 @@ func NewIntraDataClient() Client {
 	return cl
 }
 
+// pre-flight: bodyless request that gets the stream rejected (with a retryable 503) by the receiver
+// prior to sending anything - see RxAnyStream and streamBase.needPreflight
+func (s *streamBase) preflight() (err error) {
+	req, resp := fasthttp.AcquireRequest(), fasthttp.AcquireResponse()
+	req.Header.SetMethod(http.MethodHead)
+	req.SetRequestURI(s.dstURL)
+	req.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
+	req.Header.Set(apc.HdrSessNet, s.network)
+	if s.callerID != "" {
+		req.Header.Set(apc.HdrCallerID, s.callerID)
+	}
+	req.Header.Set(cos.HdrUserAgent, ua)
+	err = s.client.Do(req, resp)
+	if err == nil {
+		s.setRxLimited(string(resp.Header.Peek(apc.HdrRxLimited)))
+		if resp.StatusCode() == http.StatusServiceUnavailable {
+			err = &ErrRxBusy{s.dstURL}
+		}
+	}
+	fasthttp.ReleaseRequest(req)
+	fasthttp.ReleaseResponse(resp)
+	return
+}
+
 func (s *streamBase) do(body io.Reader) (err error) {
+	if s.needPreflight() {
+		if err = s.preflight(); err != nil {
+			if verbose.Load() {
+				glog.Errorf("%s: pre-flight error [%v]", s, err)
+			}
+			return
+		}
+	}
 	// init request & response
 	req, resp := fasthttp.AcquireRequest(), fasthttp.AcquireResponse()
 	req.Header.SetMethod(http.MethodPut)
 	req.SetRequestURI(s.dstURL)
-	req.SetBodyStream(body, -1)
+	req.SetBodyStream(s.paced(body), -1)
 	if s.streamer.compressed() {
 		req.Header.Set(apc.HdrCompress, apc.LZ4Compression)
 	}
 	req.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
+	req.Header.Set(apc.HdrSessNet, s.network)
+	if s.callerID != "" {
+		req.Header.Set(apc.HdrCallerID, s.callerID)
+	}
 	req.Header.Set(cos.HdrUserAgent, ua)
 	// do
 	err = s.client.Do(req, resp)
 	if err != nil {
-		if verbose {
+		if verbose.Load() {
 			glog.Errorf("%s: Error [%v]", s, err)
 		}
 		return
 	}
 	// handle response & cleanup
 	resp.BodyWriteTo(io.Discard)
+	s.setMaxRate(string(resp.Header.Peek(apc.HdrRxMaxRate)))
+	s.setRxLimited(string(resp.Header.Peek(apc.HdrRxLimited)))
+	if resp.StatusCode() == http.StatusServiceUnavailable {
+		err = &ErrRxBusy{s.dstURL} // rejected without pre-flight (see RxAnyStream)
+	}
 	fasthttp.ReleaseRequest(req)
 	fasthttp.ReleaseResponse(resp)
 	if s.streamer.compressed() {
@@ -51,31 +51,74 @@ func NewIntraDataClient() (client *http.Client) {
 	})
 }
 
+// pre-flight: bodyless request that gets the stream rejected (with a retryable 503) by the receiver
+// prior to sending anything - see RxAnyStream and streamBase.needPreflight
+func (s *streamBase) preflight() error {
+	request, err := http.NewRequest(http.MethodHead, s.dstURL, http.NoBody)
+	if err != nil {
+		return err
+	}
+	request.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
+	request.Header.Set(apc.HdrSessNet, s.network)
+	if s.callerID != "" {
+		request.Header.Set(apc.HdrCallerID, s.callerID)
+	}
+	request.Header.Set(cos.HdrUserAgent, ua)
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(response.Body)
+	response.Body.Close()
+	s.setRxLimited(response.Header.Get(apc.HdrRxLimited))
+	if response.StatusCode == http.StatusServiceUnavailable {
+		return &ErrRxBusy{s.dstURL}
+	}
+	return nil
+}
+
 func (s *streamBase) do(body io.Reader) (err error) {
 	var (
 		request  *http.Request
 		response *http.Response
 	)
-	if request, err = http.NewRequest(http.MethodPut, s.dstURL, body); err != nil {
+	if s.needPreflight() {
+		if err = s.preflight(); err != nil {
+			if verbose.Load() {
+				glog.Errorf("%s: pre-flight error [%v]", s, err)
+			}
+			return
+		}
+	}
+	if request, err = http.NewRequest(http.MethodPut, s.dstURL, s.paced(body)); err != nil {
 		return
 	}
 	if s.streamer.compressed() {
 		request.Header.Set(apc.HdrCompress, apc.LZ4Compression)
 	}
 	request.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
+	request.Header.Set(apc.HdrSessNet, s.network)
+	if s.callerID != "" {
+		request.Header.Set(apc.HdrCallerID, s.callerID)
+	}
 	request.Header.Set(cos.HdrUserAgent, ua)
 
 	response, err = s.client.Do(request)
 	if err != nil {
-		if verbose {
+		if verbose.Load() {
 			glog.Errorf("%s: Error [%v]", s, err)
 		}
 		return
 	}
 	cos.DrainReader(response.Body)
 	response.Body.Close()
+	s.setMaxRate(response.Header.Get(apc.HdrRxMaxRate))
+	s.setRxLimited(response.Header.Get(apc.HdrRxLimited))
 	if s.streamer.compressed() {
 		s.streamer.resetCompression()
 	}
+	if response.StatusCode == http.StatusServiceUnavailable {
+		err = &ErrRxBusy{s.dstURL} // rejected without pre-flight (see RxAnyStream)
+	}
 	return
 }
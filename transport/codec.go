@@ -0,0 +1,40 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2018-2022, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// PackCodec is an example OpaqueCodec that (de)serializes values implementing
+// cos.Packer (send side) and cos.Unpacker (receive side), respectively.
+// `New` must return a new (empty) value to unpack into.
+type PackCodec struct {
+	New func() cos.Unpacker
+}
+
+// interface guard
+var _ OpaqueCodec = (*PackCodec)(nil)
+
+func (*PackCodec) Encode(v any) ([]byte, error) {
+	p, ok := v.(cos.Packer)
+	if !ok {
+		return nil, fmt.Errorf("opaque codec: %T does not implement cos.Packer", v)
+	}
+	packer := cos.NewPacker(nil, p.PackedSize())
+	p.Pack(packer)
+	return packer.Bytes(), nil
+}
+
+func (c *PackCodec) Decode(b []byte) (any, error) {
+	u := c.New()
+	if err := u.Unpack(cos.NewUnpacker(b)); err != nil {
+		return nil, fmt.Errorf("opaque codec: failed to unpack %T: %w", u, err)
+	}
+	return u, nil
+}
@@ -25,19 +25,59 @@ const (
 	pduFl                                  // is PDU
 	pduLastFl                              // is last PDU
 	pduStreamFl                            // PDU-based stream
+	trailerFl                              // object: trailer follows the payload; trailer itself
+	seqFl                                  // object: header ends with sequence number (see ObjHdr.Seq)
+	ctrlFl                                 // sideband control frame (see Stream.SendCtrl)
+	gzipFl                                 // object: gzip-compressed payload (see ObjHdr.Gzip)
 
 	// NOTE: update when adding/changing flags :NOTE
-	allFlags = msgFl | pduFl | pduLastFl | pduStreamFl
+	allFlags = msgFl | pduFl | pduLastFl | pduStreamFl | trailerFl | seqFl | ctrlFl | gzipFl
 
 	// all 3 headers
 	sizeProtoHdr = cos.SizeofI64 * 2
+
+	// trailer: status and checksum (see ObjTrailer)
+	maxSizeTrailer = 256
 )
 
 ////////////////////////////////
 // proto header serialization //
 ////////////////////////////////
 
-func insObjHeader(hbuf []byte, hdr *ObjHdr, usePDU bool) (off int) {
+// ObjHeaderSize returns the number of bytes the object header will serialize to,
+// including the proto header (compare with `Extra.MaxHdrSize` and `config.Transport.MaxHeaderSize`);
+// NOTE: must be kept in sync with insObjHeader (and ExtObjHeader)
+func ObjHeaderSize(hdr *ObjHdr) (size int) {
+	size = sizeProtoHdr
+	size += strSize(hdr.SID) + cos.SizeofI16 /*opcode*/
+	size += strSize(hdr.Bck.Name) + strSize(hdr.Bck.Provider) + strSize(hdr.Bck.Ns.Name) + strSize(hdr.Bck.Ns.UUID)
+	size += strSize(hdr.ObjName)
+	size += cos.SizeofI16 + len(hdr.Opaque)
+	// attrs
+	attr := &hdr.ObjAttrs
+	size += cos.SizeofI64 * 2 /*size, atime*/
+	if cksum := attr.Checksum(); cksum == nil {
+		size += strSize("") * 2
+	} else {
+		size += strSize(cksum.Ty()) + strSize(cksum.Val())
+	}
+	size += strSize(attr.Ver)
+	for k, v := range attr.GetCustomMD() {
+		size += strSize(k) + strSize(v)
+	}
+	size += strSize("") // term
+	if hdr.Seq != 0 || hdr.Gzip {
+		size += cos.SizeofI64
+	}
+	if hdr.Gzip {
+		size += cos.SizeofI64
+	}
+	return size
+}
+
+func strSize(s string) int { return cos.SizeofI16 + len(s) }
+
+func insObjHeader(hbuf []byte, hdr *ObjHdr, usePDU, trailer bool) (off int) {
 	debug.Assert(usePDU || !hdr.IsUnsized())
 	off = sizeProtoHdr
 	off = insString(off, hbuf, hdr.SID)
@@ -49,10 +89,29 @@ func insObjHeader(hbuf []byte, hdr *ObjHdr, usePDU bool) (off int) {
 	off = insString(off, hbuf, hdr.ObjName)
 	off = insBytes(off, hbuf, hdr.Opaque)
 	off = insAttrs(off, hbuf, &hdr.ObjAttrs)
+	// gzip: (possibly zero) sequence number followed by the decompressed size
+	if hdr.Seq != 0 || hdr.Gzip {
+		off = insUint64(off, hbuf, hdr.Seq)
+	}
+	if hdr.Gzip {
+		off = insInt64(off, hbuf, hdr.Usize)
+	}
 	word1 := uint64(off - sizeProtoHdr)
+	if hdr.Seq != 0 {
+		word1 |= seqFl
+	}
+	if hdr.Gzip {
+		word1 |= gzipFl
+	}
+	if hdr.isCtrl() {
+		word1 |= ctrlFl
+	}
 	if usePDU {
 		word1 |= pduStreamFl
 	}
+	if trailer {
+		word1 |= trailerFl
+	}
 	insUint64(0, hbuf, word1)
 	checksum := xoshiro256.Hash(word1)
 	insUint64(cos.SizeofI64, hbuf, checksum)
@@ -71,6 +130,24 @@ func insMsg(hbuf []byte, msg *Msg) (off int) {
 	return
 }
 
+func insTrailer(hbuf []byte, tr *ObjTrailer) (off int) {
+	off = sizeProtoHdr
+	off = insInt64(off, hbuf, tr.Status)
+	if tr.Cksum == nil {
+		off = insString(off, hbuf, "")
+		off = insString(off, hbuf, "")
+	} else {
+		off = insString(off, hbuf, tr.Cksum.Ty())
+		off = insString(off, hbuf, tr.Cksum.Val())
+	}
+	debug.Assert(off <= maxSizeTrailer, off)
+	word1 := uint64(off-sizeProtoHdr) | trailerFl
+	insUint64(0, hbuf, word1)
+	checksum := xoshiro256.Hash(word1)
+	insUint64(cos.SizeofI64, hbuf, checksum)
+	return
+}
+
 func (pdu *spdu) insHeader() {
 	buf, plen := pdu.buf, pdu.plength()
 	word1 := uint64(plen) | pduFl
@@ -161,6 +238,13 @@ func ExtObjHeader(body []byte, hlen int) (hdr ObjHdr) {
 	off, hdr.ObjName = extString(off, body)
 	off, hdr.Opaque = extBytes(off, body)
 	off, hdr.ObjAttrs = extAttrs(off, body)
+	if off < hlen { // (seqFl or gzipFl)
+		off, hdr.Seq = extUint64(off, body)
+	}
+	if off < hlen { // (gzipFl)
+		off, hdr.Usize = extInt64(off, body)
+		hdr.Gzip = true
+	}
 	debug.Assertf(off == hlen, "off %d, hlen %d", off, hlen)
 	return
 }
@@ -174,6 +258,21 @@ func ExtMsg(body []byte, hlen int) (msg Msg) {
 	return
 }
 
+func extTrailer(body []byte, tlen int) (tr ObjTrailer) {
+	var (
+		cksumTyp, cksumVal string
+		off                int
+	)
+	off, tr.Status = extInt64(0, body)
+	off, cksumTyp = extString(off, body)
+	off, cksumVal = extString(off, body)
+	if cksumTyp != "" {
+		tr.Cksum = cos.NewCksum(cksumTyp, cksumVal)
+	}
+	debug.Assertf(off == tlen, "off %d, tlen %d", off, tlen)
+	return
+}
+
 func extString(off int, from []byte) (int, string) {
 	off, bt := extBytes(off, from)
 	return off, string(bt)
@@ -230,6 +329,8 @@ func (obj *Obj) IsUnsized() bool    { return obj.Hdr.IsUnsized() }
 
 func (obj *Obj) Size() int64 { return obj.Hdr.ObjSize() }
 
+func (obj *Obj) hasTrailer() bool { return obj.Trailer != nil && !obj.IsHeaderOnly() }
+
 func (obj *Obj) String() string {
 	s := fmt.Sprintf("sobj-%s", obj.Hdr.FullName())
 	if obj.IsHeaderOnly() {
@@ -250,6 +351,7 @@ func (hdr *ObjHdr) ObjSize() int64     { return hdr.ObjAttrs.Size }
 // reserved opcodes
 func (hdr *ObjHdr) isFin() bool      { return hdr.Opcode == opcFin }
 func (hdr *ObjHdr) isIdleTick() bool { return hdr.Opcode == opcIdleTick }
+func (hdr *ObjHdr) isCtrl() bool     { return hdr.Opcode == opcCtrl }
 
 ////////////////////
 // Msg and MsgHdr //
@@ -0,0 +1,236 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2018-2022, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestObjHeaderSize(t *testing.T) {
+	hdrs := []ObjHdr{
+		{},
+		{
+			Bck:     cmn.Bck{Name: "bucket", Provider: apc.AIS, Ns: cmn.Ns{UUID: "uuid", Name: "ns"}},
+			ObjName: "a/b/c/obj",
+			SID:     "t[abc]",
+			Opaque:  []byte("opaque"),
+			Opcode:  42,
+		},
+	}
+	hdr := hdrs[1]
+	hdr.ObjAttrs.Size, hdr.ObjAttrs.Atime, hdr.ObjAttrs.Ver = cos.MiB, 1234567, "v3"
+	hdr.ObjAttrs.SetCksum(cos.ChecksumXXHash, "0123456789abcdef")
+	hdr.ObjAttrs.SetCustomKey("etag", "xyz")
+	hdr.ObjAttrs.SetCustomKey("source", "remote")
+	hdrs = append(hdrs, hdr)
+
+	hbuf := make([]byte, dfltSizeHeader)
+	for i := range hdrs {
+		hdr := &hdrs[i]
+		size := ObjHeaderSize(hdr)
+		off := insObjHeader(hbuf, hdr, false /*usePDU*/, false /*trailer*/)
+		tassert.Fatalf(t, size == off, "header #%d: size %d != %d serialized", i, size, off)
+
+		hlen, flags, err := extProtoHdr(hbuf, "test")
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, flags == 0 && hlen == size-sizeProtoHdr, "header #%d: hlen %d, flags %x", i, hlen, flags)
+
+		ext := ExtObjHeader(hbuf[sizeProtoHdr:], hlen)
+		// (normalize empty)
+		if len(hdr.Opaque) == 0 {
+			ext.Opaque = hdr.Opaque
+		}
+		if hdr.ObjAttrs.Cksum == nil {
+			ext.ObjAttrs.Cksum = nil
+		}
+		tassert.Errorf(t, reflect.DeepEqual(ext, *hdr), "header #%d: %+v != %+v", i, ext, *hdr)
+	}
+}
+
+func TestObjHeaderSeq(t *testing.T) {
+	hbuf := make([]byte, dfltSizeHeader)
+	for _, seq := range []uint64{0, 1, 2, 0xffff_ffff_ffff_ffff} {
+		hdr := ObjHdr{Bck: cmn.Bck{Name: "bucket", Provider: apc.AIS}, ObjName: "obj", Seq: seq}
+		hdr.ObjAttrs.Size = cos.KiB
+		hdr.ObjAttrs.SetCustomKey("etag", "xyz")
+
+		size := ObjHeaderSize(&hdr)
+		off := insObjHeader(hbuf, &hdr, true /*usePDU*/, true /*trailer*/)
+		tassert.Fatalf(t, size == off, "seq %d: size %d != %d serialized", seq, size, off)
+
+		hlen, flags, err := extProtoHdr(hbuf, "test")
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, (flags&seqFl != 0) == (seq != 0), "seq %d: unexpected flags %s", seq, fl2s(flags))
+		tassert.Errorf(t, flags&(pduStreamFl|trailerFl) == pduStreamFl|trailerFl,
+			"seq %d: lost flags %s", seq, fl2s(flags))
+
+		ext := ExtObjHeader(hbuf[sizeProtoHdr:], hlen)
+		tassert.Errorf(t, ext.Seq == seq, "expected seq %d, got %d", seq, ext.Seq)
+		tassert.Errorf(t, ext.ObjName == hdr.ObjName && ext.ObjAttrs.Size == hdr.ObjAttrs.Size,
+			"seq %d: %+v != %+v", seq, ext, hdr)
+	}
+}
+
+// control frames interleaved with objects: the receive loop must deliver both,
+// in order, and advance past each control frame
+func TestCtrlFraming(t *testing.T) {
+	var (
+		stream bytes.Buffer
+		events []string
+		hbuf   = make([]byte, dfltSizeHeader)
+	)
+	put := func(hdr *ObjHdr, payload string) {
+		l := insObjHeader(hbuf, hdr, false /*usePDU*/, false /*trailer*/)
+		stream.Write(hbuf[:l])
+		stream.WriteString(payload)
+	}
+	obj := func(name, payload string) {
+		hdr := &ObjHdr{Bck: cmn.Bck{Name: "bucket", Provider: apc.AIS}, ObjName: name}
+		hdr.ObjAttrs.Size = int64(len(payload))
+		put(hdr, payload)
+	}
+	ctrl := func(opaque string) { put(&ObjHdr{Opcode: opcCtrl, Opaque: []byte(opaque)}, "") }
+
+	ctrl("start")
+	obj("o1", "hello")
+	ctrl("pause")
+	ctrl("resume")
+	obj("o2", "") // header-only
+	ctrl("")
+	obj("o3", "world")
+	ctrl("checkpoint")
+	put(&ObjHdr{Opcode: opcFin}, "")
+
+	// (control frame flag)
+	l := insObjHeader(hbuf, &ObjHdr{Opcode: opcCtrl}, true /*usePDU*/, false /*trailer*/)
+	_, flags, err := extProtoHdr(hbuf[:l], "test")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, flags&ctrlFl != 0 && flags&seqFl == 0, "unexpected flags %s", fl2s(flags))
+
+	rxObj := func(hdr ObjHdr, reader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		b, err := io.ReadAll(reader)
+		tassert.CheckFatal(t, err)
+		events = append(events, "obj:"+hdr.ObjName+":"+string(b))
+		return nil
+	}
+	onCtrl := func(sessID int64, opaque []byte) {
+		tassert.Errorf(t, sessID == 1234, "unexpected session ID %d", sessID)
+		events = append(events, "ctrl:"+string(opaque))
+	}
+	h := &handler{trname: "ctrl-framing", rxObj: rxObj, extra: RxExtra{OnControl: onCtrl, ProgressSize: dfltProgressSize}}
+	it := &iterator{handler: h, body: &stream, stats: &Stats{}, sessID: 1234, hbuf: make([]byte, dfltSizeHeader)}
+	err = it.rxloop(0, "test", memsys.PageMM())
+	tassert.Errorf(t, err == io.EOF && it.fin, "expected clean end-of-stream, got %v (fin %t)", err, it.fin)
+
+	expected := []string{
+		"ctrl:start", "obj:o1:hello", "ctrl:pause", "ctrl:resume", "obj:o2:", "ctrl:", "obj:o3:world", "ctrl:checkpoint",
+	}
+	tassert.Errorf(t, reflect.DeepEqual(events, expected), "expected %v, got %v", expected, events)
+	tassert.Errorf(t, it.stats.Num.Load() == 3, "expected 3 objects, got %d", it.stats.Num.Load())
+}
+
+func TestObjHeaderGzip(t *testing.T) {
+	hbuf := make([]byte, dfltSizeHeader)
+	for _, seq := range []uint64{0, 7} {
+		hdr := ObjHdr{Bck: cmn.Bck{Name: "bucket", Provider: apc.AIS}, ObjName: "obj", Seq: seq, Gzip: true, Usize: cos.MiB}
+		hdr.ObjAttrs.Size = cos.KiB
+
+		size := ObjHeaderSize(&hdr)
+		off := insObjHeader(hbuf, &hdr, false /*usePDU*/, false /*trailer*/)
+		tassert.Fatalf(t, size == off, "seq %d: size %d != %d serialized", seq, size, off)
+
+		hlen, flags, err := extProtoHdr(hbuf, "test")
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, flags&gzipFl != 0 && (flags&seqFl != 0) == (seq != 0), "seq %d: unexpected flags %s", seq, fl2s(flags))
+
+		ext := ExtObjHeader(hbuf[sizeProtoHdr:], hlen)
+		tassert.Errorf(t, ext.Gzip && ext.Usize == hdr.Usize && ext.Seq == seq && ext.ObjAttrs.Size == hdr.ObjAttrs.Size,
+			"seq %d: %+v != %+v", seq, ext, hdr)
+	}
+}
+
+// compressed and uncompressed objects in the same stream, including corrupted and
+// truncated gzip payloads: the callback gets decompressed bytes or an error, while the
+// stream itself keeps going
+func TestGzipObjects(t *testing.T) {
+	var (
+		stream bytes.Buffer
+		hbuf   = make([]byte, dfltSizeHeader)
+	)
+	compress := func(payload string) []byte {
+		var b bytes.Buffer
+		zw := gzip.NewWriter(&b)
+		_, err := zw.Write([]byte(payload))
+		tassert.CheckFatal(t, err)
+		tassert.CheckFatal(t, zw.Close())
+		return b.Bytes()
+	}
+	put := func(name string, payload []byte, gz bool, usize int) {
+		hdr := &ObjHdr{Bck: cmn.Bck{Name: "bucket", Provider: apc.AIS}, ObjName: name, Gzip: gz, Usize: int64(usize)}
+		hdr.ObjAttrs.Size = int64(len(payload))
+		l := insObjHeader(hbuf, hdr, false /*usePDU*/, false /*trailer*/)
+		stream.Write(hbuf[:l])
+		stream.Write(payload)
+	}
+	text := strings.Repeat("the quick brown fox jumps over the lazy dog; ", 100)
+
+	put("gz1", compress(text), true, len(text))
+	put("plain1", []byte("hello"), false, 0)
+	put("gz-empty", compress(""), true, 0)
+	truncated := compress(text)
+	put("gz-truncated", truncated[:len(truncated)/2], true, len(text))
+	corrupted := compress(text)
+	for i := 12; i < len(corrupted)-8; i += 4 {
+		corrupted[i] ^= 0xff
+	}
+	put("gz-corrupted", corrupted, true, len(text))
+	put("gz-not", []byte("not gzipped at all"), true, 0)
+	put("plain2", []byte("world"), false, 0)
+	put("gz2", compress("hello world"), true, 0)
+	l := insObjHeader(hbuf, &ObjHdr{Opcode: opcFin}, false /*usePDU*/, false /*trailer*/)
+	stream.Write(hbuf[:l])
+
+	var (
+		received = make(map[string]string)
+		failed   = make(map[string]error)
+	)
+	rxObj := func(hdr ObjHdr, reader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		b, err := io.ReadAll(reader)
+		if err != nil {
+			failed[hdr.ObjName] = err
+			return nil // keep going
+		}
+		if hdr.Gzip && hdr.Usize != 0 {
+			tassert.Errorf(t, int(hdr.Usize) == len(b), "%s: expected %d decompressed bytes, got %d", hdr.ObjName, hdr.Usize, len(b))
+		}
+		received[hdr.ObjName] = string(b)
+		return nil
+	}
+	h := &handler{trname: "gzip-objects", rxObj: rxObj, extra: RxExtra{ProgressSize: dfltProgressSize}}
+	it := &iterator{handler: h, body: &stream, stats: &Stats{}, sessID: 1, hbuf: make([]byte, dfltSizeHeader)}
+	err := it.rxloop(0, "test", memsys.PageMM())
+	tassert.Errorf(t, err == io.EOF && it.fin, "expected clean end-of-stream, got %v (fin %t)", err, it.fin)
+
+	expected := map[string]string{"gz1": text, "plain1": "hello", "gz-empty": "", "plain2": "world", "gz2": "hello world"}
+	tassert.Errorf(t, reflect.DeepEqual(received, expected), "expected %v, got %v", expected, received)
+	for _, name := range []string{"gz-truncated", "gz-corrupted", "gz-not"} {
+		tassert.Errorf(t, failed[name] != nil, "%s: expected decompression error", name)
+	}
+	tassert.Errorf(t, len(failed) == 3, "expected 3 failures, got %v", failed)
+}
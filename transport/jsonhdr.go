@@ -0,0 +1,130 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Alternative (JSON) object header encoding for non-Go producers.
+// The encoding is negotiated once per stream via the 16-byte preamble that takes
+// the place of the very first proto header:
+//
+//	[jsonMagic (8 bytes, big-endian)] [jsonVersion (8 bytes, big-endian)]
+//
+// and is followed by a sequence of objects, each formatted as:
+//
+//	[header length (4 bytes, big-endian)] [JSON header (see JSONHdr)] [object bytes (JSONHdr.Dsize)]
+//
+// Clean end-of-stream is denoted by a header with `"fin": true`.
+// Note that the first byte of the magic (0x01) alone does not tell the two encodings apart -
+// it is the binary proto header's first byte whenever gzipFl is the only flag set. It is the full
+// 8-byte match that does: in a binary header the remaining 7 bytes encode the header length,
+// and 0x41_49_53_4a_53_4f_4e is way beyond maxSizeHeader (see rxloop).
+const (
+	jsonMagic   = uint64(0x01_41_49_53_4a_53_4f_4e) // "\x01AISJSON"
+	jsonVersion = 1
+
+	sizeJSONLen = cos.SizeofI32
+)
+
+type JSONHdr struct {
+	Bck     cmn.Bck `json:"bck"`
+	ObjName string  `json:"objname"`
+	SID     string  `json:"sid,omitempty"`    // sender ID (optional)
+	Opaque  []byte  `json:"opaque,omitempty"` // base64
+	SessID  int64   `json:"sessid,omitempty"` // when specified, must be the same as the stream's session ID
+	Dsize   int64   `json:"dsize"`            // object size (must be known upfront)
+	Seq     uint64  `json:"seq,omitempty"`    // per-session sequence number (optional - see ObjHdr.Seq)
+	// optional content encoding: EncodingGzip (see ObjHdr.Gzip), in which case `Dsize` is
+	// the compressed (on-wire) size, and `Usize` is the decompressed one (optional)
+	Encoding string `json:"encoding,omitempty"`
+	Usize    int64  `json:"usize,omitempty"`
+	Fin      bool   `json:"fin,omitempty"` // end-of-stream
+}
+
+const EncodingGzip = "gzip"
+
+func isJSONPreamble(hbuf []byte) bool { return binary.BigEndian.Uint64(hbuf) == jsonMagic }
+
+func ExtJSONHeader(body []byte) (jhdr JSONHdr, err error) {
+	err = jsoniter.Unmarshal(body, &jhdr)
+	return
+}
+
+func (jhdr *JSONHdr) ObjHdr() (hdr ObjHdr) {
+	hdr.Bck = jhdr.Bck
+	hdr.ObjName = jhdr.ObjName
+	hdr.SID = jhdr.SID
+	hdr.Opaque = jhdr.Opaque
+	hdr.ObjAttrs.Size = jhdr.Dsize
+	hdr.Seq = jhdr.Seq
+	hdr.Gzip, hdr.Usize = jhdr.Encoding == EncodingGzip, jhdr.Usize
+	return
+}
+
+//
+// receive
+//
+
+func (it *iterator) rxloopJSON(loghdr string) (err error) {
+	if _, version := extUint64(cos.SizeofI64, it.hbuf); version != jsonVersion {
+		return fmt.Errorf("sbr14 %s: unsupported JSON header version %d (expecting %d)", loghdr, version, jsonVersion)
+	}
+	for err == nil {
+		var obj *objReader
+		obj, err = it.nextObjJSON(loghdr)
+		it.lap(&it.stats.HdrTime)
+		if obj != nil || (err != nil && err != io.EOF) {
+			err = it.deliver(loghdr, obj, err)
+			it.lap(&it.stats.PayloadTime)
+		}
+	}
+	return
+}
+
+func (it *iterator) nextObjJSON(loghdr string) (obj *objReader, err error) {
+	var n int
+	if n, err = io.ReadFull(it, it.hbuf[:sizeJSONLen]); err != nil {
+		if err == io.EOF || n > 0 {
+			return // EOF without fin (compare with nextProtoHdr)
+		}
+		return nil, fmt.Errorf("sbr15 %s: failed to receive JSON header length, err %w", loghdr, err)
+	}
+	hlen := int(binary.BigEndian.Uint32(it.hbuf))
+	if hlen > cap(it.hbuf) {
+		return nil, fmt.Errorf("sbr15 %s: JSON header length %d exceeds maximum %d", loghdr, hlen, cap(it.hbuf))
+	}
+	if _, err = io.ReadFull(it, it.hbuf[:hlen]); err != nil {
+		return nil, fmt.Errorf("sbr15 %s: failed to receive JSON header (hlen=%d), err %w", loghdr, hlen, err)
+	}
+	_ = it.stats.Offset.Add(int64(hlen + sizeJSONLen))
+
+	jhdr, err := ExtJSONHeader(it.hbuf[:hlen])
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("sbr16 %s: invalid JSON header, err %w", loghdr, err)
+	case jhdr.Fin:
+		it.fin = true
+		return nil, io.EOF
+	case jhdr.Dsize < 0:
+		return nil, fmt.Errorf("sbr16 %s: invalid object size %d (%s)", loghdr, jhdr.Dsize, jhdr.ObjName)
+	case jhdr.Encoding != "" && jhdr.Encoding != EncodingGzip:
+		return nil, fmt.Errorf("sbr16 %s: unsupported content encoding %q (%s)", loghdr, jhdr.Encoding, jhdr.ObjName)
+	case jhdr.SessID != 0 && jhdr.SessID != it.sessID:
+		return nil, fmt.Errorf("sbr16 %s: session ID mismatch (%d vs %d)", loghdr, jhdr.SessID, it.sessID)
+	}
+	obj = allocRecv()
+	obj.body, obj.hdr, obj.loghdr, obj.h, obj.peer = it.objBody(), jhdr.ObjHdr(), loghdr, it.handler, it.peer
+	obj.initCksum()
+	return
+}
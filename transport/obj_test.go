@@ -17,18 +17,28 @@ package transport_test
 // AIS_DEBUG=transport=4 go test -v -run=Multi -tags=debug -logtostderr=true
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"math"
 	"math/rand"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path"
+	"path/filepath"
 	"reflect"
+	"runtime"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -71,6 +81,10 @@ var (
 	objmux   *mux.ServeMux
 	msgmux   *mux.ServeMux
 	duration time.Duration // test duration
+
+	// makeRandReader buffers: kept apart from the PageMM that transport itself is using
+	// (note that sentCallback frees the buffer the stream has already freed upon Close)
+	randmm *memsys.MMSA
 )
 
 func TestMain(t *testing.M) {
@@ -83,6 +97,9 @@ func TestMain(t *testing.M) {
 	if duration, err = time.ParseDuration(d); err != nil {
 		cos.Exitf("Invalid duration %q", d)
 	}
+	if randmm, err = memsys.NewMMSA("rand-reader"); err != nil {
+		cos.Exitf("Failed to create MMSA: %v", err)
+	}
 
 	config := cmn.GCO.BeginUpdate()
 	config.Transport.MaxHeaderSize = memsys.PageSize
@@ -141,8 +158,8 @@ func Example_headers() {
 	stream.Fin()
 
 	// Output:
-	// {Bck:s3://@uuid#namespace/abc ObjName:X SID: Opaque:[] ObjAttrs:{Cksum:xxhash[h1] CustomMD:map[] Ver:1 Atime:663346294 Size:231} Opcode:0} (69)
-	// {Bck:ais://abracadabra ObjName:p/q/s SID: Opaque:[49 50 51] ObjAttrs:{Cksum:xxhash[h2] CustomMD:map[xx:11 yy:22] Ver:222222222222222222222222 Atime:663346294 Size:213} Opcode:0} (110)
+	// {Bck:s3://@uuid#namespace/abc ObjName:X SID: Opaque:[] OpaqueV:<nil> ObjAttrs:{Cksum:xxhash[h1] CustomMD:map[] Ver:1 Atime:663346294 Size:231} Opcode:0 Seq:0 Usize:0 Gzip:false} (69)
+	// {Bck:ais://abracadabra ObjName:p/q/s SID: Opaque:[49 50 51] OpaqueV:<nil> ObjAttrs:{Cksum:xxhash[h2] CustomMD:map[xx:11 yy:22] Ver:222222222222222222222222 Atime:663346294 Size:213} Opcode:0 Seq:0 Usize:0 Gzip:false} (110)
 }
 
 func sendText(stream *transport.Stream, txt1, txt2 string) {
@@ -450,6 +467,2435 @@ func Test_ObjAttrs(t *testing.T) {
 	}
 }
 
+func Test_RxProgress(t *testing.T) {
+	const progressSize = 4 * cos.KiB
+	var (
+		mu       sync.Mutex
+		offsets  = make(map[string][]int64)
+		trname   = "rx-progress"
+		progress = func(hdr transport.ObjHdr, received, total int64) {
+			cos.Assert(total == hdr.ObjAttrs.Size)
+			mu.Lock()
+			offsets[hdr.ObjName] = append(offsets[hdr.ObjName], received)
+			mu.Unlock()
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	_, recvFunc := makeRecvFunc(t)
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{RxProgress: progress, ProgressSize: progressSize})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	url := ts.URL + transport.ObjURLPath(trname)
+	stream := transport.NewObjStream(httpclient, url, cos.GenTie(), nil)
+
+	random := newRand(mono.NanoTime())
+	slab, _ := memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+	sizes := make(map[string]int64, 10)
+	for i := 0; i < 10; i++ {
+		hdr := genStaticHeader(random)
+		hdr.ObjAttrs.Size = int64(random.Intn(cos.MiB) + 1)
+		sizes[hdr.ObjName] = hdr.ObjAttrs.Size
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: newRandReader(random, hdr, slab)})
+	}
+	stream.Fin()
+
+	mu.Lock()
+	defer mu.Unlock()
+	tassert.Fatalf(t, len(offsets) == len(sizes), "expected progress for %d objects, got %d", len(sizes), len(offsets))
+	for name, offs := range offsets {
+		for i := 1; i < len(offs); i++ {
+			tassert.Errorf(t, offs[i] > offs[i-1], "%s: offsets must be increasing: %v", name, offs)
+		}
+		last := offs[len(offs)-1]
+		tassert.Errorf(t, last == sizes[name], "%s: last offset %d != %d size", name, last, sizes[name])
+		tassert.Errorf(t, int64(len(offs)) <= sizes[name]/progressSize+2,
+			"%s: too many progress calls (%d) for size %d", name, len(offs), sizes[name])
+	}
+}
+
+func Test_RxSkip(t *testing.T) {
+	for _, usePDU := range []bool{false, true} {
+		t.Run("pdu="+strconv.FormatBool(usePDU), func(t *testing.T) { testRxSkip(t, usePDU) })
+	}
+}
+
+func testRxSkip(t *testing.T, usePDU bool) {
+	const numObjs = 100
+	var (
+		numRecv          atomic.Int64
+		trname           = "rx-skip-" + strconv.FormatBool(usePDU)
+		skip             = func(hdr transport.ObjHdr) bool { return hdr.Opaque[0]%3 == 0 }
+		totalRecv, rxObj = makeRecvFunc(t)
+		recvFunc         = func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+			cos.Assertf(!skip(hdr), "%s: expected to be skipped", hdr.ObjName)
+			numRecv.Inc()
+			return rxObj(hdr, objReader, err)
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{Skip: skip})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	url := ts.URL + transport.ObjURLPath(trname)
+	extra := &transport.Extra{}
+	if usePDU {
+		extra.SizePDU = memsys.DefaultBufSize
+	}
+	stream := transport.NewObjStream(httpclient, url, cos.GenTie(), extra)
+
+	var (
+		random    = newRand(mono.NanoTime())
+		slab, _   = memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+		totalSend int64
+		numSend   int64
+	)
+	for i := 0; i < numObjs; i++ {
+		hdr := genStaticHeader(random)
+		hdr.Opaque = []byte{byte(i)}
+		hdr.ObjAttrs.Size = int64(random.Intn(256*cos.KiB) + 1)
+		if i%7 == 0 {
+			hdr.ObjAttrs.Size = 0 // header-only
+		}
+		if !skip(hdr) {
+			totalSend += hdr.ObjAttrs.Size
+			numSend++
+		}
+		var reader io.ReadCloser
+		if hdr.ObjAttrs.Size > 0 {
+			reader = newRandReader(random, hdr, slab)
+		}
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: reader})
+	}
+	stream.Fin()
+
+	tassert.Errorf(t, numRecv.Load() == numSend, "received %d objects, expected %d", numRecv.Load(), numSend)
+	tassert.Errorf(t, *totalRecv == totalSend, "received %d bytes, expected %d", *totalRecv, totalSend)
+}
+
+func Test_RxZeroLength(t *testing.T) {
+	for _, usePDU := range []bool{false, true} {
+		t.Run("pdu="+strconv.FormatBool(usePDU), func(t *testing.T) { testRxZeroLength(t, usePDU) })
+	}
+}
+
+// zero-length objects interleaved with non-empty ones
+func testRxZeroLength(t *testing.T, usePDU bool) {
+	const numObjs = 50
+	var (
+		sent     = make(map[string][]byte, numObjs)
+		numRecv  atomic.Int64
+		numEmpty atomic.Int64
+		trname   = "rx-zero-length-" + strconv.FormatBool(usePDU)
+		random   = newRand(mono.NanoTime())
+	)
+	for i := 0; i < numObjs; i++ {
+		var b []byte
+		if i%3 != 0 {
+			b = make([]byte, random.Intn(64*cos.KiB)+1)
+			random.Read(b)
+		}
+		sent["obj-"+strconv.Itoa(i)] = b
+	}
+	recvFunc := func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		exp := sent[hdr.ObjName]
+		if hdr.ObjAttrs.Size == 0 {
+			n, err := objReader.Read(make([]byte, 16))
+			tassert.Errorf(t, n == 0 && err == io.EOF, "%s: expected (0, EOF), got (%d, %v)", hdr.ObjName, n, err)
+			numEmpty.Inc()
+		}
+		b, err := io.ReadAll(objReader)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(b, exp), "%s: payload mismatch (%d vs %d bytes)", hdr.ObjName, len(b), len(exp))
+		numRecv.Inc()
+		return nil
+	}
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	extra := &transport.Extra{}
+	if usePDU {
+		extra.SizePDU = memsys.DefaultBufSize
+	}
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), extra)
+	var totalSize int64
+	for i := 0; i < numObjs; i++ {
+		var (
+			hdr    = transport.ObjHdr{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, ObjName: "obj-" + strconv.Itoa(i)}
+			b      = sent[hdr.ObjName]
+			reader io.ReadCloser
+		)
+		hdr.ObjAttrs.Size = int64(len(b))
+		if len(b) > 0 {
+			reader = io.NopCloser(bytes.NewReader(b))
+		}
+		totalSize += hdr.ObjAttrs.Size
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: reader})
+	}
+	stream.Fin()
+
+	tassert.Errorf(t, numRecv.Load() == numObjs, "received %d objects, expected %d", numRecv.Load(), numObjs)
+	tassert.Errorf(t, numEmpty.Load() == (numObjs+2)/3, "received %d empty objects, expected %d",
+		numEmpty.Load(), (numObjs+2)/3)
+	netstats, err := transport.GetNetworkStats(cmn.NetIntraData)
+	tassert.CheckFatal(t, err)
+	for _, stats := range netstats[trname].Sessions {
+		tassert.Errorf(t, stats.Num.Load() == numObjs, "stats: expected %d objects, got %d", numObjs, stats.Num.Load())
+		tassert.Errorf(t, stats.Dropped.Load() == 0, "stats: expected no drops, got %d", stats.Dropped.Load())
+	}
+}
+
+func Test_RxPending(t *testing.T) {
+	for _, usePDU := range []bool{false, true} {
+		t.Run("pdu="+strconv.FormatBool(usePDU), func(t *testing.T) { testRxPending(t, usePDU) })
+	}
+}
+
+// the session's `Pending` gauge must track the unread remainder of the in-progress object
+func testRxPending(t *testing.T, usePDU bool) {
+	const (
+		numObjs = 10
+		size    = 100 * cos.KiB
+		chunk   = 10 * cos.KiB
+	)
+	var (
+		trname  = "rx-pending-" + strconv.FormatBool(usePDU)
+		numRecv atomic.Int64
+	)
+	pending := func() (n int64) {
+		netstats, err := transport.GetNetworkStats(cmn.NetIntraData)
+		tassert.CheckFatal(t, err)
+		for _, stats := range netstats[trname].Sessions {
+			n += stats.Pending.Load()
+		}
+		return
+	}
+	recvFunc := func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, pending() == size, "%s: expected %d pending, got %d", hdr.ObjName, size, pending())
+		var (
+			buf = make([]byte, chunk)
+			off int64
+		)
+		for {
+			n, err := io.ReadFull(objReader, buf)
+			off += int64(n)
+			if p := pending(); p != size-off {
+				t.Errorf("%s: expected %d pending, got %d", hdr.ObjName, size-off, p)
+			}
+			if err != nil {
+				break
+			}
+		}
+		tassert.Errorf(t, off == size, "%s: read %d, expected %d", hdr.ObjName, off, size)
+		numRecv.Inc()
+		return nil
+	}
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	extra := &transport.Extra{}
+	if usePDU {
+		extra.SizePDU = memsys.DefaultBufSize
+	}
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), extra)
+	random := newRand(mono.NanoTime())
+	for i := 0; i < numObjs; i++ {
+		hdr := genStaticHeader(random)
+		hdr.ObjName = strconv.Itoa(i)
+		hdr.ObjAttrs.Size = size
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: &slowReader{size: size}})
+	}
+	stream.Fin()
+
+	tassert.Errorf(t, numRecv.Load() == numObjs, "received %d objects, expected %d", numRecv.Load(), numObjs)
+	tassert.Errorf(t, pending() == 0, "expected nothing pending upon completion, got %d", pending())
+}
+
+// the session's start time stays put while its last activity advances with each received object
+func Test_RxSessionTimes(t *testing.T) {
+	const numObjs = 5
+	var (
+		trname   = "rx-session-times"
+		recvFunc = func(_ transport.ObjHdr, objReader io.Reader, err error) error {
+			tassert.CheckFatal(t, err)
+			_, err = io.Copy(io.Discard, objReader)
+			return err
+		}
+		// wait for the object to get counted and return the (only) session's stats
+		waitNum = func(num int64) *transport.Stats {
+			for i := 0; i < 100; i++ {
+				netstats, err := transport.GetNetworkStats(cmn.NetIntraData)
+				tassert.CheckFatal(t, err)
+				for _, stats := range netstats[trname].Sessions {
+					if stats.Num.Load() == num {
+						return stats
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			t.Fatalf("timed out waiting for %d objects", num)
+			return nil
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	before := time.Now().UnixNano()
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), nil)
+	random := newRand(mono.NanoTime())
+	var start, last int64
+	for i := 0; i < numObjs; i++ {
+		hdr := genStaticHeader(random)
+		hdr.ObjAttrs.Size = cos.KiB
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: &slowReader{size: cos.KiB}})
+		stats := waitNum(int64(i + 1))
+		if i == 0 {
+			start = stats.StartTime.Load()
+			tassert.Errorf(t, start >= before, "start time %d precedes the stream (%d)", start, before)
+		} else {
+			tassert.Errorf(t, stats.StartTime.Load() == start, "start time changed: %d vs %d", stats.StartTime.Load(), start)
+		}
+		now := stats.LastActivity.Load()
+		tassert.Errorf(t, now >= start && now > last, "last activity did not advance: %d (prev %d, start %d)", now, last, start)
+		tassert.Errorf(t, now <= time.Now().UnixNano(), "last activity %d is in the future", now)
+		last = now
+		time.Sleep(10 * time.Millisecond)
+	}
+	stream.Fin()
+}
+
+func Test_RxWorkers(t *testing.T) {
+	for _, usePDU := range []bool{false, true} {
+		t.Run("pdu="+strconv.FormatBool(usePDU), func(t *testing.T) { testRxWorkers(t, usePDU) })
+	}
+}
+
+// callbacks on a bounded pool: payloads intact, concurrency bounded, and all callbacks
+// completed by the end-of-stream
+func testRxWorkers(t *testing.T, usePDU bool) {
+	const (
+		numObjs    = 100
+		numWorkers = 4
+	)
+	var (
+		sent     = make(map[string][]byte, numObjs)
+		numRecv  atomic.Int64
+		atEnd    atomic.Int64
+		active   atomic.Int64
+		peak     atomic.Int64
+		trname   = "rx-workers-" + strconv.FormatBool(usePDU)
+		random   = newRand(mono.NanoTime())
+		recvFunc = func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+			tassert.CheckFatal(t, err)
+			n := active.Inc()
+			for p := peak.Load(); n > p && !peak.CAS(p, n); p = peak.Load() {
+			}
+			b, err := io.ReadAll(objReader)
+			tassert.CheckFatal(t, err)
+			exp := sent[hdr.ObjName]
+			tassert.Errorf(t, bytes.Equal(b, exp), "%s: payload mismatch (%d vs %d bytes)", hdr.ObjName, len(b), len(exp))
+			time.Sleep(time.Millisecond)
+			active.Dec()
+			numRecv.Inc()
+			transport.FreeRecv(objReader)
+			return nil
+		}
+		onEnd = func(_ int64, err error) {
+			tassert.Errorf(t, err == nil, "expected clean end, got %v", err)
+			atEnd.Store(numRecv.Load())
+		}
+	)
+	for i := 0; i < numObjs; i++ {
+		b := make([]byte, random.Intn(256*cos.KiB))
+		random.Read(b)
+		sent["obj-"+strconv.Itoa(i)] = b
+	}
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{Workers: numWorkers, OnEnd: onEnd})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	extra := &transport.Extra{}
+	if usePDU {
+		extra.SizePDU = memsys.DefaultBufSize
+	}
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), extra)
+	for i := 0; i < numObjs; i++ {
+		hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, ObjName: "obj-" + strconv.Itoa(i)}
+		b := sent[hdr.ObjName]
+		hdr.ObjAttrs.Size = int64(len(b))
+		var reader io.ReadCloser
+		if len(b) > 0 {
+			reader = io.NopCloser(bytes.NewReader(b))
+		}
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: reader})
+	}
+	stream.Fin()
+
+	tassert.Errorf(t, numRecv.Load() == numObjs, "received %d objects, expected %d", numRecv.Load(), numObjs)
+	tassert.Errorf(t, atEnd.Load() == numObjs, "end-of-stream reported after %d callbacks, expected %d", atEnd.Load(), numObjs)
+	tassert.Errorf(t, peak.Load() <= numWorkers, "concurrent callbacks %d exceed %d workers", peak.Load(), numWorkers)
+	netstats, err := transport.GetNetworkStats(cmn.NetIntraData)
+	tassert.CheckFatal(t, err)
+	for _, stats := range netstats[trname].Sessions {
+		tassert.Errorf(t, stats.Num.Load() == numObjs, "stats: expected %d objects, got %d", numObjs, stats.Num.Load())
+		tassert.Errorf(t, stats.Dropped.Load() == 0, "stats: expected no drops, got %d", stats.Dropped.Load())
+	}
+}
+
+// per-object gzip (see ObjHdr.Gzip): compressed and uncompressed objects in the same stream,
+// with and without PDUs, inline and asynchronous receive
+func Test_RxGzip(t *testing.T) {
+	for _, usePDU := range []bool{false, true} {
+		for _, workers := range []int{0, 4} {
+			name := "pdu=" + strconv.FormatBool(usePDU) + "/workers=" + strconv.Itoa(workers)
+			t.Run(name, func(t *testing.T) { testRxGzip(t, usePDU, workers) })
+		}
+	}
+}
+
+func testRxGzip(t *testing.T, usePDU bool, workers int) {
+	const numObjs = 50
+	var (
+		sent     = make(map[string][]byte, numObjs)
+		numRecv  atomic.Int64
+		trname   = fmt.Sprintf("rx-gzip-%t-%d", usePDU, workers)
+		random   = newRand(mono.NanoTime())
+		recvFunc = func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+			tassert.CheckFatal(t, err)
+			b, err := io.ReadAll(objReader)
+			tassert.CheckFatal(t, err)
+			exp := sent[hdr.ObjName]
+			tassert.Errorf(t, bytes.Equal(b, exp), "%s: payload mismatch (%d vs %d bytes)", hdr.ObjName, len(b), len(exp))
+			if hdr.Gzip {
+				tassert.Errorf(t, hdr.Usize == int64(len(exp)), "%s: usize %d != %d", hdr.ObjName, hdr.Usize, len(exp))
+			}
+			numRecv.Inc()
+			return nil
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{Workers: workers})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	extra := &transport.Extra{}
+	if usePDU {
+		extra.SizePDU = memsys.DefaultBufSize
+	}
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), extra)
+	for i := 0; i < numObjs; i++ {
+		// (compressible content)
+		b := bytes.Repeat([]byte(strconv.Itoa(random.Int())), random.Intn(16*cos.KiB))
+		hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, ObjName: "obj-" + strconv.Itoa(i)}
+		sent[hdr.ObjName] = b
+		payload := b
+		if i%2 == 0 {
+			var zb bytes.Buffer
+			zw := gzip.NewWriter(&zb)
+			_, err := zw.Write(b)
+			tassert.CheckFatal(t, err)
+			tassert.CheckFatal(t, zw.Close())
+			payload = zb.Bytes()
+			hdr.Gzip, hdr.Usize = true, int64(len(b))
+		}
+		hdr.ObjAttrs.Size = int64(len(payload))
+		var reader io.ReadCloser
+		if len(payload) > 0 {
+			reader = io.NopCloser(bytes.NewReader(payload))
+		}
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: reader})
+	}
+	stream.Fin()
+
+	tassert.Errorf(t, numRecv.Load() == numObjs, "received %d objects, expected %d", numRecv.Load(), numObjs)
+}
+
+// CPU-bound receive callback: inline vs. bounded pool of workers
+// e.g. go test -run=NONE -bench=RxWorkers
+func Benchmark_RxWorkers(b *testing.B) {
+	for _, numWorkers := range []int{0, runtime.GOMAXPROCS(0)} {
+		b.Run("workers="+strconv.Itoa(numWorkers), func(b *testing.B) { benchRxWorkers(b, numWorkers) })
+	}
+}
+
+func benchRxWorkers(b *testing.B, numWorkers int) {
+	const (
+		size   = 64 * cos.KiB
+		rounds = 8 // hashing rounds per object, to make the callback CPU-heavy
+	)
+	var (
+		trname   = "rx-workers-bench-" + strconv.Itoa(numWorkers)
+		payload  = make([]byte, size)
+		recvFunc = func(_ transport.ObjHdr, objReader io.Reader, err error) error {
+			if err != nil {
+				return err
+			}
+			buf, err := io.ReadAll(objReader)
+			if err != nil {
+				return err
+			}
+			for i := 0; i < rounds; i++ {
+				h := sha256.Sum256(buf)
+				buf[0] ^= h[0]
+			}
+			return nil
+		}
+	)
+	rand.Read(payload)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{Workers: numWorkers})
+	tassert.CheckFatal(b, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), nil)
+	hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, ObjName: "bench"}
+	hdr.ObjAttrs.Size = size
+
+	b.SetBytes(size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(bytes.NewReader(payload))})
+	}
+	stream.Fin()
+}
+
+// odd-sized (and smaller than headers) chunks must not break framing
+func Test_RxReadSize(t *testing.T) {
+	for _, readSize := range []int{17, 1000, 64 * cos.KiB} {
+		for _, usePDU := range []bool{false, true} {
+			for _, compress := range []bool{false, true} {
+				name := fmt.Sprintf("size=%d/pdu=%t/compress=%t", readSize, usePDU, compress)
+				t.Run(name, func(t *testing.T) { testRxReadSize(t, readSize, usePDU, compress) })
+			}
+		}
+	}
+}
+
+func testRxReadSize(t *testing.T, readSize int, usePDU, compress bool) {
+	const numObjs = 50
+	var (
+		sent     = make(map[string][]byte, numObjs)
+		numRecv  atomic.Int64
+		trname   = fmt.Sprintf("rx-read-size-%d-%t-%t", readSize, usePDU, compress)
+		random   = newRand(mono.NanoTime())
+		recvFunc = func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+			tassert.CheckFatal(t, err)
+			b, err := io.ReadAll(objReader)
+			tassert.CheckFatal(t, err)
+			exp := sent[hdr.ObjName]
+			tassert.Errorf(t, bytes.Equal(b, exp), "%s: payload mismatch (%d vs %d bytes)", hdr.ObjName, len(b), len(exp))
+			numRecv.Inc()
+			return nil
+		}
+	)
+	for i := 0; i < numObjs; i++ {
+		b := make([]byte, random.Intn(128*cos.KiB))
+		random.Read(b)
+		sent["obj-"+strconv.Itoa(i)] = b
+	}
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{ReadSize: readSize})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	extra := &transport.Extra{}
+	if usePDU {
+		extra.SizePDU = memsys.DefaultBufSize
+	}
+	if compress {
+		extra.Compression = apc.CompressAlways
+	}
+	if compress {
+		extra.Compression = apc.CompressAlways
+	}
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), extra)
+	for i := 0; i < numObjs; i++ {
+		hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, ObjName: "obj-" + strconv.Itoa(i)}
+		b := sent[hdr.ObjName]
+		hdr.ObjAttrs.Size = int64(len(b))
+		var reader io.ReadCloser
+		if len(b) > 0 {
+			reader = io.NopCloser(bytes.NewReader(b))
+		}
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: reader})
+	}
+	stream.Fin()
+	tassert.Errorf(t, numRecv.Load() == numObjs, "received %d objects, expected %d", numRecv.Load(), numObjs)
+}
+
+// receive-side read granularity vs. a callback that reads in small chunks
+// e.g. go test -run=NONE -bench=RxReadSize
+func Benchmark_RxReadSize(b *testing.B) {
+	for _, readSize := range []int{0, 64 * cos.KiB, cos.MiB} {
+		for _, compress := range []bool{false, true} {
+			name := fmt.Sprintf("size=%d/compress=%t", readSize, compress)
+			b.Run(name, func(b *testing.B) { benchRxReadSize(b, readSize, compress) })
+		}
+	}
+}
+
+func benchRxReadSize(b *testing.B, readSize int, compress bool) {
+	const (
+		size  = cos.MiB
+		chunk = 4 * cos.KiB // callback's reads
+	)
+	var (
+		trname   = fmt.Sprintf("rx-read-size-bench-%d-%t", readSize, compress)
+		payload  = make([]byte, size)
+		recvFunc = func(_ transport.ObjHdr, objReader io.Reader, err error) error {
+			if err != nil {
+				return err
+			}
+			buf := make([]byte, chunk)
+			for {
+				if _, err := objReader.Read(buf); err != nil {
+					if err == io.EOF {
+						return nil
+					}
+					return err
+				}
+			}
+		}
+	)
+	rand.Read(payload)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{ReadSize: readSize})
+	tassert.CheckFatal(b, err)
+	defer transport.Unhandle(trname)
+
+	var extra *transport.Extra
+	if compress {
+		extra = &transport.Extra{Compression: apc.CompressAlways}
+	}
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), extra)
+	hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, ObjName: "bench"}
+	hdr.ObjAttrs.Size = size
+
+	b.SetBytes(size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(bytes.NewReader(payload))})
+	}
+	stream.Fin()
+}
+
+// stalls mid-object (see Test_RxReadTimeout)
+type slowReader struct {
+	stall time.Duration
+	size  int64
+	off   int64
+}
+
+func (r *slowReader) Read(p []byte) (n int, err error) {
+	if r.off >= r.size {
+		return 0, io.EOF
+	}
+	if r.off >= r.size/2 && r.stall > 0 {
+		time.Sleep(r.stall)
+		r.stall = 0
+	}
+	n = int(cos.MinI64(int64(len(p)), r.size-r.off))
+	r.off += int64(n)
+	return
+}
+
+func (*slowReader) Close() error { return nil }
+
+func Test_RxReadTimeout(t *testing.T) {
+	const (
+		size    = 256 * cos.KiB
+		timeout = 200 * time.Millisecond
+	)
+	var (
+		mu       sync.Mutex
+		errs     []error
+		trname   = "rx-read-timeout"
+		recvFunc = func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+			if err == nil {
+				_, err = io.Copy(io.Discard, objReader)
+			}
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+			return nil // NOTE: the stream must fail regardless
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{ReadTimeout: timeout})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	url := ts.URL + transport.ObjURLPath(trname)
+	stream := transport.NewObjStream(httpclient, url, cos.GenTie(), nil)
+
+	random := newRand(mono.NanoTime())
+	for i, stall := range []time.Duration{0, 5 * timeout} {
+		hdr := genStaticHeader(random)
+		hdr.ObjName = strconv.Itoa(i)
+		hdr.ObjAttrs.Size = size
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: &slowReader{size: size, stall: stall}})
+		if i == 0 {
+			time.Sleep(2 * timeout) // idle in-between objects is not subject to the timeout
+		}
+	}
+	stream.Fin()
+
+	mu.Lock()
+	defer mu.Unlock()
+	tassert.Fatalf(t, len(errs) == 2, "expected 2 received objects, got %d", len(errs))
+	tassert.Errorf(t, errs[0] == nil, "unexpected error: %v", errs[0])
+	tassert.Errorf(t, transport.IsErrReadTimeout(errs[1]), "expected read timeout, got %v", errs[1])
+}
+
+func Test_RxRejected(t *testing.T) {
+	trname := "rx-rejected"
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	_, recvFunc := makeRecvFunc(t)
+	err := transport.HandleObjStream(trname, recvFunc)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	// not a stream: garbage in place of the protocol header
+	garbage := make([]byte, 64)
+	newRand(mono.NanoTime()).Read(garbage)
+	req, err := http.NewRequest(http.MethodPut, ts.URL+transport.ObjURLPath(trname), bytes.NewReader(garbage))
+	tassert.CheckFatal(t, err)
+	req.Header.Set(apc.HdrSessID, "1234")
+	resp, err := http.DefaultClient.Do(req)
+	tassert.CheckFatal(t, err)
+	resp.Body.Close()
+	tassert.Errorf(t, resp.StatusCode >= http.StatusBadRequest, "expected error status, got %d", resp.StatusCode)
+
+	netstats, err := transport.GetNetworkStats(cmn.NetIntraData)
+	tassert.CheckFatal(t, err)
+	eps := netstats[trname].Sessions
+	tassert.Fatalf(t, len(eps) == 1, "expected one session, got %d", len(eps))
+	for _, stats := range eps {
+		tassert.Errorf(t, stats.Rejected.Load() == 1, "expected 1 rejected, got %d", stats.Rejected.Load())
+		tassert.Errorf(t, stats.Num.Load() == 0, "expected 0 received, got %d", stats.Num.Load())
+	}
+}
+
+// many tiny objects: framing overhead is measured and reported
+func Test_HdrTime(t *testing.T) {
+	const numObjs = 10000
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	random := newRand(mono.NanoTime())
+	for _, test := range []struct {
+		trname   string
+		noTiming bool
+	}{
+		{"hdr-time", false},
+		{"hdr-time-disabled", true},
+	} {
+		t.Run(test.trname, func(t *testing.T) {
+			_, recvFunc := makeRecvFunc(t)
+			extra := &transport.RxExtra{NoTiming: test.noTiming, ReadSize: 64 * cos.KiB} // (full-length reads)
+			err := transport.HandleObjStream(test.trname, recvFunc, extra)
+			tassert.CheckFatal(t, err)
+			defer transport.Unhandle(test.trname)
+
+			var (
+				body    = &bytes.Buffer{}
+				sw      = transport.NewStreamWriter(body)
+				payload = []byte("tiny")
+			)
+			for i := 0; i < numObjs; i++ {
+				hdr := genStaticHeader(random)
+				hdr.ObjAttrs.Size = int64(len(payload))
+				tassert.CheckFatal(t, sw.WriteObj(&hdr, bytes.NewReader(payload)))
+			}
+			tassert.CheckFatal(t, sw.Fin())
+			req, err := http.NewRequest(http.MethodPut, ts.URL+transport.ObjURLPath(test.trname), body)
+			tassert.CheckFatal(t, err)
+			req.Header.Set(apc.HdrSessID, "1")
+			resp, err := http.DefaultClient.Do(req)
+			tassert.CheckFatal(t, err)
+			resp.Body.Close()
+			tassert.Fatalf(t, resp.StatusCode == http.StatusOK, "expected status %d, got %d", http.StatusOK, resp.StatusCode)
+
+			netstats, err := transport.GetNetworkStats(cmn.NetIntraData)
+			tassert.CheckFatal(t, err)
+			eps := netstats[test.trname].Sessions
+			tassert.Fatalf(t, len(eps) == 1, "expected one session, got %d", len(eps))
+			for _, stats := range eps {
+				var (
+					hdrTime, payloadTime = stats.HdrTime.Load(), stats.PayloadTime.Load()
+					fraction             = stats.HdrTimeFraction()
+				)
+				tassert.Errorf(t, stats.Num.Load() == numObjs, "expected %d received, got %d", numObjs, stats.Num.Load())
+				if test.noTiming {
+					tassert.Errorf(t, hdrTime == 0 && payloadTime == 0 && fraction == 0,
+						"expected no timing, got hdr %d, payload %d", hdrTime, payloadTime)
+					continue
+				}
+				tassert.Errorf(t, hdrTime > 0 && payloadTime > 0, "expected both timed, got hdr %d, payload %d",
+					hdrTime, payloadTime)
+				tassert.Errorf(t, fraction > 0 && fraction < 1, "expected header time fraction in (0, 1), got %f", fraction)
+				tlog.Logf("%s: header time %v (%.1f%%), payload time %v\n", test.trname,
+					time.Duration(hdrTime), fraction*100, time.Duration(payloadTime))
+			}
+		})
+	}
+}
+
+// the callback writes received objects to disk while relying on the running checksum
+// (see RxExtra.VerifyCksum) to reject the corrupted one - all in a single pass
+func Test_Verified(t *testing.T) {
+	const (
+		numObjs = 20
+		trname  = "verified"
+		corrupt = 7
+	)
+	var (
+		dir      = t.TempDir()
+		verified int
+		rejected []string
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	recvFunc := func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		if err != nil {
+			return err
+		}
+		fqn := filepath.Join(dir, hdr.ObjName)
+		fh, err := cos.CreateFile(fqn)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(fh, objReader)
+		fh.Close()
+		if err != nil {
+			return err
+		}
+		ok, err := transport.Verified(objReader)
+		if err != nil {
+			tassert.Errorf(t, cos.IsErrBadCksum(err), "%s: expected bad checksum, got %v", hdr.ObjName, err)
+			rejected = append(rejected, hdr.ObjName)
+			return os.Remove(fqn)
+		}
+		tassert.Errorf(t, ok, "%s: expected verified", hdr.ObjName)
+		verified++
+		return nil
+	}
+	extra := &transport.RxExtra{VerifyCksum: true, ReadSize: 64 * cos.KiB} // (full-length reads)
+	err := transport.HandleObjStream(trname, recvFunc, extra)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	var (
+		body   = &bytes.Buffer{}
+		sw     = transport.NewStreamWriter(body)
+		random = newRand(mono.NanoTime())
+	)
+	for i := 0; i < numObjs; i++ {
+		payload := make([]byte, random.Intn(100*cos.KiB)+1)
+		random.Read(payload)
+		cksum, err := cos.ChecksumBytes(payload, cos.ChecksumXXHash)
+		tassert.CheckFatal(t, err)
+		if i == corrupt {
+			payload[len(payload)/2]++ // (in flight)
+		}
+		hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, ObjName: "obj-" + strconv.Itoa(i)}
+		hdr.ObjAttrs.Size = int64(len(payload))
+		hdr.ObjAttrs.Cksum = cksum
+		tassert.CheckFatal(t, sw.WriteObj(&hdr, bytes.NewReader(payload)))
+	}
+	tassert.CheckFatal(t, sw.Fin())
+	req, err := http.NewRequest(http.MethodPut, ts.URL+transport.ObjURLPath(trname), body)
+	tassert.CheckFatal(t, err)
+	req.Header.Set(apc.HdrSessID, "1")
+	resp, err := http.DefaultClient.Do(req)
+	tassert.CheckFatal(t, err)
+	resp.Body.Close()
+	tassert.Fatalf(t, resp.StatusCode == http.StatusOK, "expected status %d, got %d", http.StatusOK, resp.StatusCode)
+
+	tassert.Errorf(t, verified == numObjs-1, "expected %d verified, got %d", numObjs-1, verified)
+	expected := "obj-" + strconv.Itoa(corrupt)
+	tassert.Fatalf(t, len(rejected) == 1 && rejected[0] == expected, "expected %q rejected, got %v", expected, rejected)
+	tassert.Errorf(t, cos.Stat(filepath.Join(dir, expected)) != nil, "expected %q removed", expected)
+}
+
+// counts buffers acquired from (and returned to) the underlying slab allocator
+type countingPool struct {
+	*memsys.MMSA
+	out    map[*byte]bool // outstanding
+	allocs int
+	frees  int
+	mu     sync.Mutex
+}
+
+func (p *countingPool) AllocSize(size int64) ([]byte, *memsys.Slab) {
+	buf, slab := p.MMSA.AllocSize(size)
+	p.mu.Lock()
+	p.out[&buf[0]] = true
+	p.allocs++
+	p.mu.Unlock()
+	return buf, slab
+}
+
+func (p *countingPool) Free(buf []byte) {
+	p.mu.Lock()
+	delete(p.out, &buf[0])
+	p.frees++
+	p.mu.Unlock()
+	p.MMSA.Free(buf)
+}
+
+func Test_RxBufPool(t *testing.T) {
+	const (
+		numObjs = 20
+		trname  = "rx-buf-pool"
+	)
+	var (
+		pool     = &countingPool{MMSA: memsys.PageMM(), out: make(map[*byte]bool)}
+		received int64
+	)
+	_ = memsys.ByteMM() // sibling allocator for the buffers of small (under 4KiB) objects
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	recvFunc := func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		buf := transport.GetReadBuf(objReader)
+		if hdr.IsHeaderOnly() {
+			tassert.Errorf(t, buf == nil, "%s: header-only object must not get a buffer", hdr.ObjName)
+			return nil
+		}
+		tassert.Fatalf(t, len(buf) > 0, "%s: expected a read buffer", hdr.ObjName)
+		pool.mu.Lock()
+		outstanding := len(pool.out)
+		pool.mu.Unlock()
+		tassert.Errorf(t, outstanding == 1, "%s: expected exactly one outstanding buffer, got %d", hdr.ObjName, outstanding)
+
+		// (a plain writer, so that io.CopyBuffer does use the buffer)
+		n, err := io.CopyBuffer(struct{ io.Writer }{io.Discard}, objReader, buf)
+		tassert.Errorf(t, n == hdr.ObjAttrs.Size, "%s: expected %d bytes, got %d", hdr.ObjName, hdr.ObjAttrs.Size, n)
+		received += n
+		return err
+	}
+	extra := &transport.RxExtra{BufPool: pool}
+	err := transport.HandleObjStream(trname, recvFunc, extra)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	var (
+		body   = &bytes.Buffer{}
+		sw     = transport.NewStreamWriter(body)
+		random = newRand(mono.NanoTime())
+		total  int64
+	)
+	for i := 0; i < numObjs; i++ {
+		size := random.Intn(256*cos.KiB) + 1
+		if i == numObjs/2 {
+			size = 0 // header-only
+		}
+		payload := make([]byte, size)
+		random.Read(payload)
+		hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, ObjName: "obj-" + strconv.Itoa(i)}
+		hdr.ObjAttrs.Size = int64(size)
+		tassert.CheckFatal(t, sw.WriteObj(&hdr, bytes.NewReader(payload)))
+		total += int64(size)
+	}
+	tassert.CheckFatal(t, sw.Fin())
+	req, err := http.NewRequest(http.MethodPut, ts.URL+transport.ObjURLPath(trname), body)
+	tassert.CheckFatal(t, err)
+	req.Header.Set(apc.HdrSessID, "1")
+	resp, err := http.DefaultClient.Do(req)
+	tassert.CheckFatal(t, err)
+	resp.Body.Close()
+	tassert.Fatalf(t, resp.StatusCode == http.StatusOK, "expected status %d, got %d", http.StatusOK, resp.StatusCode)
+
+	tassert.Errorf(t, received == total, "expected %d bytes total, got %d", total, received)
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	tassert.Errorf(t, pool.allocs == numObjs-1, "expected %d buffers acquired, got %d", numObjs-1, pool.allocs)
+	tassert.Errorf(t, pool.frees == pool.allocs, "expected all %d buffers returned, got %d", pool.allocs, pool.frees)
+	tassert.Errorf(t, len(pool.out) == 0, "expected no outstanding buffers, got %d", len(pool.out))
+}
+
+func Test_RxMaxObjSize(t *testing.T) {
+	const (
+		maxSize = 4 * cos.KiB
+		trname  = "rx-max-obj-size"
+	)
+	var (
+		mu       sync.Mutex
+		received []string
+		progress = make(map[string]int64)
+		endErr   = make(chan error, 1)
+		recvFunc = func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+			tassert.CheckFatal(t, err)
+			_, err = io.Copy(io.Discard, objReader)
+			mu.Lock()
+			received = append(received, hdr.ObjName)
+			mu.Unlock()
+			return err
+		}
+		extra = &transport.RxExtra{
+			MaxObjSize:   maxSize,
+			ProgressSize: 1,
+			RxProgress: func(hdr transport.ObjHdr, n, _ int64) {
+				mu.Lock()
+				progress[hdr.ObjName] = n
+				mu.Unlock()
+			},
+			OnEnd: func(_ int64, err error) { endErr <- err },
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc, extra)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), nil)
+	random := newRand(mono.NanoTime())
+	for _, size := range []int64{maxSize, maxSize + 1} {
+		hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, ObjName: "obj-" + strconv.FormatInt(size, 10)}
+		hdr.ObjAttrs.Size = size
+		b := make([]byte, size)
+		random.Read(b)
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(bytes.NewReader(b))})
+	}
+	stream.Fin()
+
+	select {
+	case err = <-endErr:
+		tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "exceeds the maximum"),
+			"expected oversized object error, got %v", err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the end of stream")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	over := "obj-" + strconv.Itoa(maxSize+1)
+	tassert.Errorf(t, len(received) == 1 && received[0] == "obj-"+strconv.Itoa(maxSize),
+		"expected to receive only the first object, got %v", received)
+	_, ok := progress[over]
+	tassert.Errorf(t, !ok, "oversized object's payload must not be read (progress %d)", progress[over])
+
+	netstats, err := transport.GetNetworkStats(cmn.NetIntraData)
+	tassert.CheckFatal(t, err)
+	eps := netstats[trname].Sessions
+	tassert.Fatalf(t, len(eps) == 1, "expected one session, got %d", len(eps))
+	for _, stats := range eps {
+		tassert.Errorf(t, stats.Oversized.Load() == 1, "expected 1 oversized, got %d", stats.Oversized.Load())
+		tassert.Errorf(t, stats.Rejected.Load() == 1, "expected 1 rejected, got %d", stats.Rejected.Load())
+		tassert.Errorf(t, stats.Pending.Load() == 0, "expected zero pending, got %d", stats.Pending.Load())
+	}
+}
+
+// PDU-based objects, sized and unsized, get checked as they grow (see readPDU)
+func Test_RxMaxObjSizePDU(t *testing.T) {
+	const (
+		maxSize = 2 * memsys.DefaultBufSize
+		trname  = "rx-max-obj-size-pdu"
+	)
+	var (
+		mu       sync.Mutex
+		received []string
+		rxerrs   []string
+		endErr   = make(chan error, 1)
+		recvFunc = func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+			tassert.CheckFatal(t, err)
+			_, err = io.Copy(io.Discard, objReader)
+			mu.Lock()
+			if err == nil {
+				received = append(received, hdr.ObjName)
+			} else {
+				rxerrs = append(rxerrs, hdr.ObjName)
+			}
+			mu.Unlock()
+			return nil // (the stream must terminate regardless)
+		}
+		extra = &transport.RxExtra{
+			MaxObjSize: maxSize,
+			OnEnd:      func(_ int64, err error) { endErr <- err },
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc, extra)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(),
+		&transport.Extra{SizePDU: memsys.DefaultBufSize})
+	random := newRand(mono.NanoTime())
+	for _, size := range []int64{maxSize, maxSize * 4, maxSize} {
+		hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, ObjName: "obj-" + strconv.FormatInt(size, 10)}
+		hdr.ObjAttrs.Size = transport.SizeUnknown
+		b := make([]byte, size)
+		random.Read(b)
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(bytes.NewReader(b))})
+	}
+	stream.Fin()
+
+	select {
+	case err = <-endErr:
+		tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "exceeds the maximum"),
+			"expected oversized object error, got %v", err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the end of stream")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	over := "obj-" + strconv.Itoa(maxSize*4)
+	tassert.Errorf(t, len(received) == 1 && received[0] == "obj-"+strconv.Itoa(maxSize),
+		"expected to receive only the first object, got %v", received)
+	tassert.Errorf(t, len(rxerrs) == 1 && rxerrs[0] == over,
+		"expected read error for %s, got %v", over, rxerrs)
+
+	netstats, err := transport.GetNetworkStats(cmn.NetIntraData)
+	tassert.CheckFatal(t, err)
+	eps := netstats[trname].Sessions
+	tassert.Fatalf(t, len(eps) == 1, "expected one session, got %d", len(eps))
+	for _, stats := range eps {
+		tassert.Errorf(t, stats.Oversized.Load() == 1, "expected 1 oversized, got %d", stats.Oversized.Load())
+		tassert.Errorf(t, stats.Dropped.Load() == 1, "expected 1 dropped, got %d", stats.Dropped.Load())
+		tassert.Errorf(t, stats.Pending.Load() == 0, "expected zero pending, got %d", stats.Pending.Load())
+	}
+}
+
+func Test_ObjSeq(t *testing.T) {
+	for _, seqOn := range []bool{false, true} {
+		t.Run(fmt.Sprintf("seq=%t", seqOn), func(t *testing.T) { testObjSeq(t, seqOn) })
+	}
+}
+
+func testObjSeq(t *testing.T, seqOn bool) {
+	const numObjs = 100
+	var (
+		mu       sync.Mutex
+		seqs     []uint64
+		trname   = fmt.Sprintf("obj-seq-%t", seqOn)
+		random   = newRand(mono.NanoTime())
+		recvFunc = func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+			tassert.CheckFatal(t, err)
+			cos.DrainReader(objReader)
+			mu.Lock()
+			seqs = append(seqs, hdr.Seq)
+			mu.Unlock()
+			return nil
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(),
+		&transport.Extra{Seq: seqOn})
+	for i := 0; i < numObjs; i++ {
+		hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, ObjName: "obj-" + strconv.Itoa(i)}
+		var reader io.ReadCloser
+		if i%3 != 0 { // including header-only
+			b := make([]byte, random.Intn(cos.KiB)+1)
+			random.Read(b)
+			hdr.ObjAttrs.Size = int64(len(b))
+			reader = io.NopCloser(bytes.NewReader(b))
+		}
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: reader})
+	}
+	stream.Fin()
+
+	mu.Lock()
+	defer mu.Unlock()
+	tassert.Fatalf(t, len(seqs) == numObjs, "received %d objects, expected %d", len(seqs), numObjs)
+	for i, seq := range seqs {
+		exp := uint64(0)
+		if seqOn {
+			exp = uint64(i + 1)
+		}
+		tassert.Fatalf(t, seq == exp, "object #%d: expected seq %d, got %d", i, exp, seq)
+	}
+}
+
+func Test_RxControl(t *testing.T) {
+	for _, usePDU := range []bool{false, true} {
+		t.Run("pdu="+strconv.FormatBool(usePDU), func(t *testing.T) { testRxControl(t, usePDU) })
+	}
+}
+
+func testRxControl(t *testing.T, usePDU bool) {
+	const (
+		numObjs   = 100
+		ctrlEvery = 7
+	)
+	var (
+		mu       sync.Mutex
+		events   []string // in the receive order
+		numCmpl  atomic.Int64
+		trname   = "rx-control-" + strconv.FormatBool(usePDU)
+		random   = newRand(mono.NanoTime())
+		recvFunc = func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+			tassert.CheckFatal(t, err)
+			cos.DrainReader(objReader)
+			mu.Lock()
+			events = append(events, fmt.Sprintf("obj-%d", hdr.Seq))
+			mu.Unlock()
+			return nil
+		}
+		onCtrl = func(_ int64, opaque []byte) {
+			mu.Lock()
+			events = append(events, "ctrl-"+string(opaque))
+			mu.Unlock()
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{OnControl: onCtrl})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	extra := &transport.Extra{
+		Seq:      true,
+		Callback: func(transport.ObjHdr, io.ReadCloser, any, error) { numCmpl.Inc() },
+	}
+	if usePDU {
+		extra.SizePDU = memsys.DefaultBufSize
+	}
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), extra)
+	expected := make([]string, 0, numObjs+numObjs/ctrlEvery)
+	for i := 0; i < numObjs; i++ {
+		if i%ctrlEvery == 0 {
+			opaque := strconv.Itoa(i)
+			tassert.CheckFatal(t, stream.SendCtrl([]byte(opaque)))
+			expected = append(expected, "ctrl-"+opaque)
+		}
+		hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, ObjName: "obj-" + strconv.Itoa(i)}
+		var reader io.ReadCloser
+		if i%3 != 0 { // including header-only
+			b := make([]byte, random.Intn(cos.KiB)+1)
+			random.Read(b)
+			hdr.ObjAttrs.Size = int64(len(b))
+			reader = io.NopCloser(bytes.NewReader(b))
+		}
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: reader})
+		expected = append(expected, fmt.Sprintf("obj-%d", i+1)) // (control frames are not sequenced)
+	}
+	stream.Fin()
+
+	mu.Lock()
+	defer mu.Unlock()
+	tassert.Errorf(t, reflect.DeepEqual(events, expected), "expected %v, got %v", expected, events)
+	tassert.Errorf(t, numCmpl.Load() == numObjs, "expected %d completions, got %d", numObjs, numCmpl.Load())
+	stats := stream.GetStats()
+	tassert.Errorf(t, stats.Num.Load() == numObjs, "expected %d objects sent, got %d", numObjs, stats.Num.Load())
+}
+
+func Test_RxOnEnd(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		ends   = make(map[int64]error)
+		trname = "rx-on-end"
+		onEnd  = func(sessID int64, err error) {
+			mu.Lock()
+			ends[sessID] = err
+			mu.Unlock()
+		}
+		endErr = func(sessID int64) (err error, ok bool) {
+			mu.Lock()
+			err, ok = ends[sessID]
+			mu.Unlock()
+			return
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	_, recvFunc := makeRecvFunc(t)
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{OnEnd: onEnd})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	rawPut := func(sessID int64, body []byte) {
+		req, err := http.NewRequest(http.MethodPut, ts.URL+transport.ObjURLPath(trname), bytes.NewReader(body))
+		tassert.CheckFatal(t, err)
+		req.Header.Set(apc.HdrSessID, strconv.FormatInt(sessID, 10))
+		resp, err := http.DefaultClient.Do(req)
+		tassert.CheckFatal(t, err)
+		resp.Body.Close()
+	}
+
+	t.Run("clean", func(t *testing.T) {
+		httpclient := transport.NewIntraDataClient()
+		stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), nil)
+		random := newRand(mono.NanoTime())
+		slab, _ := memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+		for i := 0; i < 10; i++ {
+			hdr := genStaticHeader(random)
+			hdr.ObjAttrs.Size = int64(random.Intn(cos.MiB) + 1)
+			stream.Send(&transport.Obj{Hdr: hdr, Reader: newRandReader(random, hdr, slab)})
+		}
+		stream.Fin()
+
+		mu.Lock()
+		defer mu.Unlock()
+		tassert.Fatalf(t, len(ends) == 1, "expected one session end, got %d", len(ends))
+		for sessID, err := range ends {
+			tassert.Errorf(t, err == nil, "session %d: expected clean end, got %v", sessID, err)
+			delete(ends, sessID)
+		}
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		const sessID = 4321
+		rawPut(sessID, nil) // EOF without the last marker
+		err, ok := endErr(sessID)
+		tassert.Fatalf(t, ok, "session %d: end-of-stream not reported", sessID)
+		tassert.Errorf(t, err == io.ErrUnexpectedEOF, "expected %v, got %v", io.ErrUnexpectedEOF, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		const sessID = 8765
+		garbage := make([]byte, 64)
+		newRand(mono.NanoTime()).Read(garbage)
+		rawPut(sessID, garbage)
+		err, ok := endErr(sessID)
+		tassert.Fatalf(t, ok, "session %d: end-of-stream not reported", sessID)
+		tassert.Errorf(t, err != nil && err != io.ErrUnexpectedEOF, "expected stream error, got %v", err)
+	})
+}
+
+func Test_RxSessionClose(t *testing.T) {
+	type closed struct {
+		trname string
+		sessID int64
+		num    int64
+		offset int64
+	}
+	var (
+		trname  = "rx-session-close"
+		closeCh = make(chan closed, 4)
+		onClose = func(trname string, sessID int64, final *transport.Stats) {
+			closeCh <- closed{trname, sessID, final.Num.Load(), final.Offset.Load()}
+		}
+		next = func() (c closed) {
+			select {
+			case c = <-closeCh:
+			case <-time.After(10 * time.Second):
+				t.Fatal("timed out waiting for session close")
+			}
+			return
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	_, recvFunc := makeRecvFunc(t)
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{OnSessionClose: onClose})
+	tassert.CheckFatal(t, err)
+
+	var (
+		httpclient = transport.NewIntraDataClient()
+		stream     = transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), nil)
+		random     = newRand(mono.NanoTime())
+		slab, _    = memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+		numObjs    = int64(10)
+	)
+	for i := int64(0); i < numObjs; i++ {
+		hdr := genStaticHeader(random)
+		hdr.ObjAttrs.Size = int64(random.Intn(cos.MiB) + 1)
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: newRandReader(random, hdr, slab)})
+	}
+	stream.Fin()
+
+	// end of stream
+	c := next()
+	tassert.Errorf(t, c.trname == trname, "expected %q, got %q", trname, c.trname)
+	tassert.Errorf(t, c.num == numObjs, "expected %d objects, got %d", numObjs, c.num)
+	tassert.Errorf(t, c.offset > 0, "expected non-zero stream offset")
+
+	// endpoint unregistered - the final call, same totals
+	tassert.CheckFatal(t, transport.Unhandle(trname))
+	f := next()
+	tassert.Errorf(t, f == c, "expected final %+v, got %+v", c, f)
+	select {
+	case c := <-closeCh:
+		t.Errorf("unexpected session close %+v", c)
+	default:
+	}
+}
+
+// receiver under (simulated) load advertises max send rate; cooperating senders honor it
+func Test_RxMaxRate(t *testing.T) {
+	const (
+		trname  = "rx-max-rate"
+		maxRate = 4 * cos.MiB
+		minTime = 3 * time.Second / 2 // (2*maxRate bytes at maxRate, less one-second burst allowance)
+	)
+	var (
+		loaded    atomic.Bool // e.g., disk saturation
+		endCh     = make(chan error, 4)
+		random    = newRand(mono.NanoTime())
+		maxRateCB = func(_ string, _ int64, stats *transport.Stats) int64 {
+			if loaded.Load() && stats.Offset.Load() > 0 {
+				return maxRate
+			}
+			return 0
+		}
+		onEnd = func(_ int64, err error) { endCh <- err }
+		ended = func() {
+			select {
+			case <-endCh:
+			case <-time.After(20 * time.Second):
+				t.Fatal("timed out waiting for end of stream")
+			}
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	_, recvFunc := makeRecvFunc(t)
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{MaxRate: maxRateCB, OnEnd: onEnd})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	put := func(t *testing.T, sessID string) int64 {
+		var (
+			body = &bytes.Buffer{}
+			sw   = transport.NewStreamWriter(body)
+			hdr  = genStaticHeader(random)
+		)
+		hdr.ObjAttrs.Size = cos.KiB
+		tassert.CheckFatal(t, sw.WriteObj(&hdr, bytes.NewReader(make([]byte, cos.KiB))))
+		tassert.CheckFatal(t, sw.Fin())
+		req, err := http.NewRequest(http.MethodPut, ts.URL+transport.ObjURLPath(trname), body)
+		tassert.CheckFatal(t, err)
+		req.Header.Set(apc.HdrSessID, sessID)
+		resp, err := http.DefaultClient.Do(req)
+		tassert.CheckFatal(t, err)
+		resp.Body.Close()
+		ended()
+		tassert.Errorf(t, resp.StatusCode == http.StatusOK, "expected status %d, got %d", http.StatusOK, resp.StatusCode)
+		return transport.RxMaxRate(resp.Header)
+	}
+
+	t.Run("idle", func(t *testing.T) {
+		rate := put(t, "1")
+		tassert.Errorf(t, rate == 0, "expected no max rate, got %d", rate)
+	})
+	loaded.Store(true)
+	t.Run("loaded", func(t *testing.T) {
+		rate := put(t, "2")
+		tassert.Errorf(t, rate == maxRate, "expected max rate %d, got %d", maxRate, rate)
+	})
+
+	t.Run("stream-writer", func(t *testing.T) {
+		var (
+			sw      = transport.NewStreamWriter(io.Discard)
+			hdr     = genStaticHeader(random)
+			started = time.Now()
+		)
+		sw.SetMaxRate(maxRate)
+		hdr.ObjAttrs.Size = 2 * maxRate
+		tassert.CheckFatal(t, sw.WriteObj(&hdr, bytes.NewReader(make([]byte, hdr.ObjAttrs.Size))))
+		elapsed := time.Since(started)
+		tassert.Errorf(t, elapsed >= minTime, "expected paced write to take at least %v, took %v", minTime, elapsed)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		var (
+			httpclient = transport.NewIntraDataClient()
+			extra      = &transport.Extra{IdleTeardown: time.Second}
+			stream     = transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), extra)
+			slab, _    = memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+		)
+		// first session: learn the rate upon idle teardown
+		hdr := genStaticHeader(random)
+		hdr.ObjAttrs.Size = cos.KiB
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: newRandReader(random, hdr, slab)})
+		ended()
+
+		// next session: paced
+		started := time.Now()
+		hdr = genStaticHeader(random)
+		hdr.ObjAttrs.Size = 2 * maxRate
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: newRandReader(random, hdr, slab)})
+		stream.Fin()
+		ended()
+		elapsed := time.Since(started)
+		tassert.Errorf(t, elapsed >= minTime, "expected paced stream to take at least %v, took %v", minTime, elapsed)
+	})
+}
+
+// invalid registrations fail upfront, with a typed error
+func Test_HandleInvalid(t *testing.T) {
+	_, recvFunc := makeRecvFunc(t)
+	recvMsg := func(transport.Msg, error) error { return nil }
+	for _, test := range []struct {
+		name string
+		reg  func() error
+	}{
+		{"nil-obj-callback", func() error { return transport.HandleObjStream("nil-obj-callback", nil) }},
+		{"nil-msg-callback", func() error { return transport.HandleMsgStream("nil-msg-callback", nil) }},
+		{"empty-obj-trname", func() error { return transport.HandleObjStream("", recvFunc) }},
+		{"empty-msg-trname", func() error { return transport.HandleMsgStream("", recvMsg) }},
+		{"slash", func() error { return transport.HandleObjStream("a/b", recvFunc) }},
+		{"dot-dot", func() error { return transport.HandleObjStream("..", recvFunc) }},
+		{"space", func() error { return transport.HandleObjStream("a b", recvFunc) }},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.reg()
+			tassert.Fatalf(t, err != nil, "expected registration to fail")
+			tassert.Errorf(t, transport.IsErrInvalidHandler(err), "expected invalid-handler error, got %v", err)
+		})
+	}
+	// valid (and then duplicate)
+	trname := "handle-valid_" + cos.GenTie()
+	tassert.CheckFatal(t, transport.HandleObjStream(trname, recvFunc))
+	defer transport.Unhandle(trname)
+	err := transport.HandleObjStream(trname, recvFunc)
+	tassert.Errorf(t, transport.IsErrDuplicateTrname(err), "expected duplicate trname error, got %v", err)
+}
+
+func Test_Handlers(t *testing.T) {
+	var (
+		tie      = cos.GenTie()
+		objNames = []string{"enum-obj-b-" + tie, "enum-obj-a-" + tie}
+		msgNames = []string{"enum-msg-" + tie}
+		recvMsg  = func(transport.Msg, error) error { return nil }
+	)
+	_, recvFunc := makeRecvFunc(t)
+	for _, trname := range objNames {
+		tassert.CheckFatal(t, transport.HandleObjStream(trname, recvFunc))
+	}
+	for _, trname := range msgNames {
+		tassert.CheckFatal(t, transport.HandleMsgStream(trname, recvMsg))
+	}
+	contains := func(all, names []string) bool {
+		for _, name := range names {
+			if !cos.StringInSlice(name, all) {
+				return false
+			}
+		}
+		return true
+	}
+
+	endpoints := transport.Endpoints()
+	tassert.Errorf(t, contains(endpoints, []string{apc.ObjStream, apc.MsgStream}), "unexpected endpoints %v", endpoints)
+
+	objHandlers := transport.Handlers(apc.ObjStream)
+	tassert.Errorf(t, contains(objHandlers, objNames), "expected %v in %v", objNames, objHandlers)
+	tassert.Errorf(t, !contains(objHandlers, msgNames), "unexpected %v in %v", msgNames, objHandlers)
+	tassert.Errorf(t, sort.StringsAreSorted(objHandlers), "expected sorted, got %v", objHandlers)
+
+	msgHandlers := transport.Handlers(apc.MsgStream)
+	tassert.Errorf(t, contains(msgHandlers, msgNames), "expected %v in %v", msgNames, msgHandlers)
+	tassert.Errorf(t, !contains(msgHandlers, objNames[:1]), "unexpected %v in %v", objNames, msgHandlers)
+
+	all := transport.Handlers("")
+	tassert.Errorf(t, contains(all, append(objNames, msgNames...)), "expected all handlers in %v", all)
+	tassert.Errorf(t, len(transport.Handlers("unknown")) == 0, "expected no handlers for unknown endpoint")
+
+	// unregistered
+	for _, trname := range append(objNames, msgNames...) {
+		tassert.CheckFatal(t, transport.Unhandle(trname))
+	}
+	all = transport.Handlers("")
+	for _, trname := range append(objNames, msgNames...) {
+		tassert.Errorf(t, !cos.StringInSlice(trname, all), "%q must be gone, got %v", trname, all)
+	}
+}
+
+func Test_RxMaxStreams(t *testing.T) {
+	const (
+		trname  = "rx-max-streams"
+		numObjs = 10
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	var (
+		numRecv, numErrs atomic.Int64
+		release          = make(chan struct{})
+	)
+	recvFunc := func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		if hdr.ObjName == "blocker" {
+			<-release
+		}
+		_, err = io.Copy(io.Discard, objReader)
+		numRecv.Inc()
+		return err
+	}
+	err := transport.HandleObjStream(trname, recvFunc)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	config := cmn.GCO.BeginUpdate()
+	config.Transport.MaxRxStreams = 1
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Transport.MaxRxStreams = 0
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	var (
+		slab, _ = memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+		random  = newRand(mono.NanoTime())
+		url     = ts.URL + transport.ObjURLPath(trname)
+		cb      = func(_ transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+			if err != nil {
+				numErrs.Inc()
+			}
+		}
+		send = func(stream *transport.Stream, name string) {
+			hdr := genStaticHeader(random)
+			hdr.ObjName = name
+			hdr.ObjAttrs.Size = int64(random.Intn(cos.MiB) + 1)
+			stream.Send(&transport.Obj{Hdr: hdr, Reader: newRandReader(random, hdr, slab)})
+		}
+		getStats = func(network string) transport.NetEndpointStats {
+			netstats, err := transport.GetNetworkStats(network)
+			tassert.CheckFatal(t, err)
+			return netstats[trname]
+		}
+	)
+
+	// first stream: stays active until released
+	first := transport.NewObjStream(transport.NewIntraDataClient(), url, cos.GenTie(), &transport.Extra{Callback: cb})
+	send(first, "blocker")
+	for i := 0; getStats(cmn.NetIntraData).Active != 1; i++ {
+		tassert.Fatalf(t, i < 100, "timed out waiting for the first stream")
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// second stream: over the limit - gets rejected upon pre-flight, backs off, and retries
+	second := transport.NewObjStream(transport.NewIntraDataClient(), url, cos.GenTie(), &transport.Extra{Callback: cb})
+	for i := 0; i < numObjs; i++ {
+		send(second, "obj-"+strconv.Itoa(i))
+	}
+	for i := 0; getStats(cmn.NetIntraData).Busy == 0; i++ {
+		tassert.Fatalf(t, i < 100, "timed out waiting for the second stream to get rejected")
+		time.Sleep(10 * time.Millisecond)
+	}
+	tassert.Errorf(t, numRecv.Load() == 0, "received %d objects while over the limit", numRecv.Load())
+
+	// a PUT that skips the pre-flight gets rejected all the same
+	busy := getStats(cmn.NetIntraData).Busy
+	body, err := hex.DecodeString(jsonStreamVector)
+	tassert.CheckFatal(t, err)
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(body))
+	tassert.CheckFatal(t, err)
+	req.Header.Set(apc.HdrSessID, "5151")
+	resp, err := http.DefaultClient.Do(req)
+	tassert.CheckFatal(t, err)
+	resp.Body.Close()
+	tassert.Errorf(t, resp.StatusCode == http.StatusServiceUnavailable, "expected %d, got %d",
+		http.StatusServiceUnavailable, resp.StatusCode)
+	tassert.Errorf(t, getStats(cmn.NetIntraData).Busy > busy, "expected busy count to increase")
+	tassert.Errorf(t, numRecv.Load() == 0, "received %d objects while over the limit", numRecv.Load())
+
+	// the limit is per network
+	other := transport.NewObjStream(transport.NewIntraDataClient(), url, cos.GenTie(),
+		&transport.Extra{Callback: cb, Network: cmn.NetIntraControl})
+	for i := 0; i < numObjs; i++ {
+		send(other, "other-"+strconv.Itoa(i))
+	}
+	other.Fin()
+	tassert.Errorf(t, numRecv.Load() == numObjs, "received %d objects via %s, expected %d",
+		numRecv.Load(), cmn.NetIntraControl, numObjs)
+	tassert.Errorf(t, getStats(cmn.NetIntraControl).Busy == 0, "%s must not be affected", cmn.NetIntraControl)
+
+	close(release)
+	first.Fin()
+	second.Fin()
+
+	// nothing's lost
+	tassert.Errorf(t, numErrs.Load() == 0, "%d objects failed to send", numErrs.Load())
+	tassert.Errorf(t, numRecv.Load() == 2*numObjs+1, "received %d objects, expected %d", numRecv.Load(), 2*numObjs+1)
+
+	eps := getStats(cmn.NetIntraData)
+	tassert.Errorf(t, eps.Active == 0, "expected no active streams, got %d", eps.Active)
+	tassert.Errorf(t, eps.Peak == 1, "expected peak 1, got %d", eps.Peak)
+}
+
+// senders pre-flight only when the receiver may reject new streams (see apc.HdrRxLimited)
+func Test_RxPreflight(t *testing.T) {
+	const numSessions = 2
+	var numHead atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			numHead.Inc()
+		}
+		objmux.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	for _, test := range []struct {
+		name  string
+		extra *transport.RxExtra
+		heads int64
+	}{
+		{"unlimited", &transport.RxExtra{}, 1},                            // the first session only
+		{"accept-rate", &transport.RxExtra{AcceptRate: 100}, numSessions}, // every session
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				trname  = "rx-preflight-" + test.name
+				numRecv atomic.Int64
+				ended   = make(chan struct{}, numSessions)
+				random  = newRand(mono.NanoTime())
+				slab, _ = memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+			)
+			recvFunc := func(_ transport.ObjHdr, objReader io.Reader, err error) error {
+				tassert.CheckFatal(t, err)
+				_, err = io.Copy(io.Discard, objReader)
+				numRecv.Inc()
+				return err
+			}
+			test.extra.OnEnd = func(int64, error) { ended <- struct{}{} }
+			tassert.CheckFatal(t, transport.HandleObjStream(trname, recvFunc, test.extra))
+			defer transport.Unhandle(trname)
+
+			numHead.Store(0)
+			extra := &transport.Extra{IdleTeardown: time.Second}
+			stream := transport.NewObjStream(transport.NewIntraDataClient(), ts.URL+transport.ObjURLPath(trname),
+				cos.GenTie(), extra)
+			for i := 0; i < numSessions; i++ {
+				hdr := genStaticHeader(random)
+				hdr.ObjAttrs.Size = cos.KiB
+				stream.Send(&transport.Obj{Hdr: hdr, Reader: newRandReader(random, hdr, slab)})
+				if i == numSessions-1 {
+					stream.Fin()
+				}
+				select {
+				case <-ended: // idle teardown (and, finally, end of stream)
+				case <-time.After(10 * time.Second):
+					t.Fatalf("timed out waiting for session %d to end", i+1)
+				}
+			}
+			tassert.Errorf(t, numRecv.Load() == numSessions, "received %d objects, expected %d", numRecv.Load(), numSessions)
+			tassert.Errorf(t, numHead.Load() == test.heads, "expected %d pre-flights, got %d", test.heads, numHead.Load())
+		})
+	}
+}
+
+func Test_RxAcceptRate(t *testing.T) {
+	const (
+		trname     = "rx-accept-rate"
+		acceptRate = 5
+		numStreams = 3 * acceptRate
+		numObjs    = 4
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	var numRecv, numErrs atomic.Int64
+	recvFunc := func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		_, err = io.Copy(io.Discard, objReader)
+		numRecv.Inc()
+		return err
+	}
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{AcceptRate: acceptRate})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	var (
+		wg      sync.WaitGroup
+		slab, _ = memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+		started = mono.NanoTime()
+		cb      = func(_ transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+			if err != nil {
+				numErrs.Inc()
+			}
+		}
+	)
+	for i := 0; i < numStreams; i++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			random := newRand(seed)
+			stream := transport.NewObjStream(transport.NewIntraDataClient(), ts.URL+transport.ObjURLPath(trname),
+				cos.GenTie(), &transport.Extra{Callback: cb})
+			for j := 0; j < numObjs; j++ {
+				hdr := genStaticHeader(random)
+				hdr.ObjAttrs.Size = int64(random.Intn(cos.KiB*64) + 1)
+				stream.Send(&transport.Obj{Hdr: hdr, Reader: newRandReader(random, hdr, slab)})
+			}
+			stream.Fin()
+		}(mono.NanoTime() + int64(i))
+	}
+	wg.Wait()
+
+	// throttled streams back off and retry - nothing's lost
+	tassert.Errorf(t, numErrs.Load() == 0, "%d objects failed to send", numErrs.Load())
+	tassert.Errorf(t, numRecv.Load() == numStreams*numObjs, "received %d objects, expected %d",
+		numRecv.Load(), numStreams*numObjs)
+
+	netstats, err := transport.GetNetworkStats(cmn.NetIntraData)
+	tassert.CheckFatal(t, err)
+	eps := netstats[trname]
+	tlog.Logf("accepted %d, throttled %d (in %v)\n", eps.Accepted, eps.Throttled, mono.Since(started))
+	tassert.Errorf(t, eps.Accepted == numStreams, "accepted %d, expected %d", eps.Accepted, numStreams)
+	tassert.Errorf(t, eps.Throttled >= numStreams-acceptRate, "throttled %d, expected at least %d",
+		eps.Throttled, numStreams-acceptRate)
+
+	// same burst of PUTs that skip the pre-flight (e.g., StreamWriter, older peers) gets throttled all the same
+	body, err := hex.DecodeString(jsonStreamVector)
+	tassert.CheckFatal(t, err)
+	var numBusy atomic.Int64
+	for i := 0; i < numStreams; i++ {
+		wg.Add(1)
+		go func(sessID int) {
+			defer wg.Done()
+			req, err := http.NewRequest(http.MethodPut, ts.URL+transport.ObjURLPath(trname), bytes.NewReader(body))
+			tassert.CheckFatal(t, err)
+			req.Header.Set(apc.HdrSessID, strconv.Itoa(sessID))
+			resp, err := http.DefaultClient.Do(req)
+			tassert.CheckFatal(t, err)
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusServiceUnavailable {
+				tassert.Errorf(t, resp.Header.Get(cos.HdrRetryAfter) != "", "expected %q header", cos.HdrRetryAfter)
+				numBusy.Inc()
+			}
+		}(1000 + i)
+	}
+	wg.Wait()
+	tassert.Errorf(t, numBusy.Load() >= numStreams-acceptRate, "throttled %d PUTs, expected at least %d",
+		numBusy.Load(), numStreams-acceptRate)
+	netstats, err = transport.GetNetworkStats(cmn.NetIntraData)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, netstats[trname].Throttled == eps.Throttled+numBusy.Load(), "throttled %d, expected %d",
+		netstats[trname].Throttled, eps.Throttled+numBusy.Load())
+	tassert.Errorf(t, netstats[trname].Accepted == eps.Accepted+numStreams-numBusy.Load(), "accepted %d, expected %d",
+		netstats[trname].Accepted, eps.Accepted+numStreams-numBusy.Load())
+
+	// the rate is per network
+	netstats, err = transport.GetNetworkStats(cmn.NetIntraControl)
+	tassert.CheckFatal(t, err)
+	eps = netstats[trname]
+	tassert.Errorf(t, eps.Accepted == 0 && eps.Throttled == 0, "%s: unexpected (%d, %d)",
+		cmn.NetIntraControl, eps.Accepted, eps.Throttled)
+}
+
+func Test_RxPause(t *testing.T) {
+	trname := "rx-pause"
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	var totalRecv atomic.Int64
+	recvFunc := func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		written, err := io.Copy(io.Discard, objReader)
+		tassert.CheckFatal(t, err)
+		totalRecv.Add(written)
+		return nil
+	}
+	err := transport.HandleObjStream(trname, recvFunc)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	slab, _ := memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+	var (
+		random = newRand(mono.NanoTime())
+		send   = func(stream *transport.Stream, num int) (size int64) {
+			for i := 0; i < num; i++ {
+				hdr := genStaticHeader(random)
+				hdr.ObjAttrs.Size = int64(random.Intn(cos.MiB) + 1)
+				size += hdr.ObjAttrs.Size
+				stream.Send(&transport.Obj{Hdr: hdr, Reader: newRandReader(random, hdr, slab)})
+			}
+			return
+		}
+	)
+
+	t.Run("new-stream", func(t *testing.T) {
+		tassert.CheckFatal(t, transport.PauseHandler(cmn.NetIntraData, trname))
+		tassert.Fatalf(t, transport.IsHandlerPaused(cmn.NetIntraData, trname), "expected %q to be paused", trname)
+		netstats, err := transport.GetNetworkStats(cmn.NetIntraData)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, netstats[trname].Paused, "expected %q stats to report paused", trname)
+
+		// pre-flight
+		req, err := http.NewRequest(http.MethodHead, ts.URL+transport.ObjURLPath(trname), http.NoBody)
+		tassert.CheckFatal(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		tassert.CheckFatal(t, err)
+		resp.Body.Close()
+		tassert.Errorf(t, resp.StatusCode == http.StatusServiceUnavailable, "expected %d, got %d",
+			http.StatusServiceUnavailable, resp.StatusCode)
+		tassert.Errorf(t, resp.Header.Get(cos.HdrRetryAfter) != "", "expected %q header", cos.HdrRetryAfter)
+
+		// new stream backs off while paused
+		totalRecv.Store(0)
+		stream := transport.NewObjStream(transport.NewIntraDataClient(), ts.URL+transport.ObjURLPath(trname), cos.GenTie(), nil)
+		size := send(stream, 10)
+		time.Sleep(100 * time.Millisecond)
+		tassert.Errorf(t, totalRecv.Load() == 0, "received %d while paused", totalRecv.Load())
+
+		// resume => accepting new streams (and nothing's lost)
+		tassert.CheckFatal(t, transport.ResumeHandler(cmn.NetIntraData, trname))
+		tassert.Fatalf(t, !transport.IsHandlerPaused(cmn.NetIntraData, trname), "expected %q to be resumed", trname)
+		netstats, err = transport.GetNetworkStats(cmn.NetIntraData)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, !netstats[trname].Paused, "expected %q stats to report resumed", trname)
+		stream.Fin()
+		tassert.Errorf(t, totalRecv.Load() == size, "received %d, expected %d", totalRecv.Load(), size)
+	})
+
+	t.Run("other-network", func(t *testing.T) {
+		tassert.CheckFatal(t, transport.PauseHandler(cmn.NetIntraData, trname))
+		defer transport.ResumeHandler(cmn.NetIntraData, trname)
+		tassert.Fatalf(t, !transport.IsHandlerPaused(cmn.NetIntraControl, trname),
+			"expected %q to be paused on %s only", trname, cmn.NetIntraData)
+
+		// same trname, different network
+		totalRecv.Store(0)
+		extra := &transport.Extra{Network: cmn.NetIntraControl}
+		stream := transport.NewObjStream(transport.NewIntraDataClient(), ts.URL+transport.ObjURLPath(trname), cos.GenTie(), extra)
+		size := send(stream, 10)
+		stream.Fin()
+		tassert.Errorf(t, totalRecv.Load() == size, "received %d, expected %d", totalRecv.Load(), size)
+
+		netstats, err := transport.GetNetworkStats(cmn.NetIntraControl)
+		tassert.CheckFatal(t, err)
+		eps := netstats[trname]
+		tassert.Errorf(t, !eps.Paused, "expected %q on %s not to be paused", trname, cmn.NetIntraControl)
+		tassert.Errorf(t, len(eps.Sessions) == 1, "expected one %s session, got %d", cmn.NetIntraControl, len(eps.Sessions))
+	})
+
+	t.Run("no-pre-flight", func(t *testing.T) {
+		body, err := hex.DecodeString(jsonStreamVector)
+		tassert.CheckFatal(t, err)
+		put := func() *http.Response {
+			req, err := http.NewRequest(http.MethodPut, ts.URL+transport.ObjURLPath(trname), bytes.NewReader(body))
+			tassert.CheckFatal(t, err)
+			req.Header.Set(apc.HdrSessID, "5151")
+			resp, err := http.DefaultClient.Do(req)
+			tassert.CheckFatal(t, err)
+			resp.Body.Close()
+			return resp
+		}
+		totalRecv.Store(0)
+		tassert.CheckFatal(t, transport.PauseHandler(cmn.NetIntraData, trname))
+
+		// a PUT that skips the pre-flight gets rejected all the same
+		resp := put()
+		tassert.Errorf(t, resp.StatusCode == http.StatusServiceUnavailable, "expected %d, got %d",
+			http.StatusServiceUnavailable, resp.StatusCode)
+		tassert.Errorf(t, resp.Header.Get(cos.HdrRetryAfter) != "", "expected %q header", cos.HdrRetryAfter)
+		tassert.Errorf(t, totalRecv.Load() == 0, "received %d while paused", totalRecv.Load())
+
+		tassert.CheckFatal(t, transport.ResumeHandler(cmn.NetIntraData, trname))
+		resp = put()
+		tassert.Errorf(t, resp.StatusCode == http.StatusOK, "expected %d, got %d", http.StatusOK, resp.StatusCode)
+		tassert.Errorf(t, totalRecv.Load() == 5, "received %d, expected 5", totalRecv.Load())
+	})
+
+	t.Run("in-flight", func(t *testing.T) {
+		totalRecv.Store(0)
+		stream := transport.NewObjStream(transport.NewIntraDataClient(), ts.URL+transport.ObjURLPath(trname), cos.GenTie(), nil)
+		size := send(stream, 10)
+		for i := 0; totalRecv.Load() == 0; i++ {
+			tassert.Fatalf(t, i < 100, "timed out waiting for the stream to start")
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		tassert.CheckFatal(t, transport.PauseHandler(cmn.NetIntraData, trname))
+		defer transport.ResumeHandler(cmn.NetIntraData, trname)
+
+		// the stream that's already in progress drains to completion
+		size += send(stream, 10)
+		stream.Fin()
+		tassert.Errorf(t, totalRecv.Load() == size, "received %d, expected %d", totalRecv.Load(), size)
+	})
+
+	err = transport.PauseHandler(cmn.NetIntraData, "no-such-trname")
+	tassert.Errorf(t, err != nil, "expected error pausing unknown endpoint")
+	err = transport.PauseHandler("no-such-network", trname)
+	tassert.Errorf(t, err != nil, "expected error pausing unknown network")
+}
+
+// computes checksum of the payload that's being sent (see Test_Trailer)
+type cksumReader struct {
+	io.ReadCloser
+	cksum *cos.CksumHash
+}
+
+func (r *cksumReader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(p)
+	r.cksum.H.Write(p[:n])
+	return
+}
+
+// conformance vector for the JSON header encoding (see transport/README.md):
+// preamble, one 5-byte object, and the last marker
+const jsonStreamVector = "014149534a534f4e0000000000000001" +
+	"0000005d" + "7b2262636b223a7b226e616d65223a226a62636b222c2270726f7669646572223a22616973227d2c226f626a6e616d65223a22" +
+	"6f626a31222c22736964223a22657874222c22736573736964223a353135312c226473697a65223a357d" + "68656c6c6f" +
+	"0000000c" + "7b2266696e223a747275657d"
+
+func Test_RxJSONHeader(t *testing.T) {
+	const (
+		trname = "rx-json-hdr"
+		sessID = 5151
+	)
+	var (
+		hdrs    []transport.ObjHdr
+		payload []byte
+		endErr  = errors.New("not ended")
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	recvFunc := func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		b, err := io.ReadAll(objReader)
+		tassert.CheckFatal(t, err)
+		hdrs = append(hdrs, hdr)
+		payload = append(payload, b...)
+		return nil
+	}
+	onEnd := func(_ int64, err error) { endErr = err }
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{OnEnd: onEnd})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	body, err := hex.DecodeString(jsonStreamVector)
+	tassert.CheckFatal(t, err)
+	req, err := http.NewRequest(http.MethodPut, ts.URL+transport.ObjURLPath(trname), bytes.NewReader(body))
+	tassert.CheckFatal(t, err)
+	req.Header.Set(apc.HdrSessID, strconv.Itoa(sessID))
+	resp, err := http.DefaultClient.Do(req)
+	tassert.CheckFatal(t, err)
+	resp.Body.Close()
+	tassert.Errorf(t, resp.StatusCode == http.StatusOK, "expected status %d, got %d", http.StatusOK, resp.StatusCode)
+
+	tassert.Errorf(t, endErr == nil, "expected clean end-of-stream, got %v", endErr)
+	tassert.Fatalf(t, len(hdrs) == 1, "expected one object, got %d", len(hdrs))
+	hdr := hdrs[0]
+	tassert.Errorf(t, hdr.Bck.Equal(&cmn.Bck{Name: "jbck", Provider: apc.AIS}), "unexpected bucket %s", hdr.Bck)
+	tassert.Errorf(t, hdr.ObjName == "obj1" && hdr.SID == "ext", "unexpected header %+v", hdr)
+	tassert.Errorf(t, hdr.ObjAttrs.Size == 5 && string(payload) == "hello", "unexpected payload %q", payload)
+}
+
+// round trip: StreamWriter => RxAnyStream (and the same framing as Stream's - see PackHeader)
+func Test_StreamWriter(t *testing.T) {
+	const (
+		trname  = "stream-writer"
+		numObjs = 10
+	)
+	var (
+		hdrs     []transport.ObjHdr
+		payloads [][]byte
+		endErr   = errors.New("not ended")
+		random   = newRand(mono.NanoTime())
+		body     = &bytes.Buffer{}
+		sw       = transport.NewStreamWriter(body)
+		sent     = make([]transport.ObjHdr, 0, numObjs)
+		data     = make([][]byte, 0, numObjs)
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	recvFunc := func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		b, err := io.ReadAll(objReader)
+		tassert.CheckFatal(t, err)
+		hdr.Opaque = append([]byte(nil), hdr.Opaque...) // (valid only for the duration of the callback)
+		hdrs = append(hdrs, hdr)
+		payloads = append(payloads, b)
+		return nil
+	}
+	onEnd := func(_ int64, err error) { endErr = err }
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{OnEnd: onEnd})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	for i := 0; i < numObjs; i++ {
+		hdr := genStaticHeader(random)
+		hdr.ObjAttrs.Size = int64(random.Intn(64 * cos.KiB))
+		if i == 0 {
+			hdr.ObjAttrs.Size = 0 // header-only
+		}
+		if i%3 == 1 {
+			hdr.Seq = uint64(i)
+		}
+		b := make([]byte, hdr.ObjAttrs.Size)
+		random.Read(b)
+		tassert.CheckFatal(t, sw.WriteObj(&hdr, bytes.NewReader(b)))
+		sent = append(sent, hdr)
+		data = append(data, b)
+
+		packed := transport.PackHeader(&hdr)
+		tassert.Errorf(t, len(packed) == transport.ObjHeaderSize(&hdr), "packed %d != %d", len(packed), transport.ObjHeaderSize(&hdr))
+	}
+
+	// invalid
+	unsized := genStaticHeader(random)
+	unsized.ObjAttrs.Size = transport.SizeUnknown
+	tassert.Errorf(t, sw.WriteObj(&unsized, bytes.NewReader(nil)) != nil, "expected error writing unsized object")
+	reserved := genStaticHeader(random)
+	reserved.Opcode = math.MaxUint16 - 1
+	tassert.Errorf(t, sw.WriteObj(&reserved, bytes.NewReader(nil)) != nil, "expected error writing reserved opcode")
+	short := genStaticHeader(random)
+	short.ObjAttrs.Size = 100
+	tassert.Errorf(t, transport.NewStreamWriter(io.Discard).WriteObj(&short, bytes.NewReader(make([]byte, 99))) != nil,
+		"expected error writing short payload")
+
+	tassert.CheckFatal(t, sw.Fin())
+	tassert.Errorf(t, sw.WriteObj(&sent[0], nil) != nil, "expected error writing after fin")
+
+	req, err := http.NewRequest(http.MethodPut, ts.URL+transport.ObjURLPath(trname), body)
+	tassert.CheckFatal(t, err)
+	req.Header.Set(apc.HdrSessID, "1")
+	resp, err := http.DefaultClient.Do(req)
+	tassert.CheckFatal(t, err)
+	resp.Body.Close()
+	tassert.Errorf(t, resp.StatusCode == http.StatusOK, "expected status %d, got %d", http.StatusOK, resp.StatusCode)
+
+	tassert.Errorf(t, endErr == nil, "expected clean end-of-stream, got %v", endErr)
+	tassert.Fatalf(t, len(hdrs) == numObjs, "expected %d objects, got %d", numObjs, len(hdrs))
+	for i, hdr := range hdrs {
+		exp := &sent[i]
+		tassert.Errorf(t, hdr.Bck.Equal(&exp.Bck) && hdr.ObjName == exp.ObjName, "%d: expected %s, got %s",
+			i, exp.FullName(), hdr.FullName())
+		tassert.Errorf(t, bytes.Equal(hdr.Opaque, exp.Opaque), "%d: opaque mismatch", i)
+		tassert.Errorf(t, hdr.ObjAttrs.Size == exp.ObjAttrs.Size && hdr.ObjAttrs.Checksum().Equal(exp.ObjAttrs.Checksum()) &&
+			reflect.DeepEqual(hdr.ObjAttrs.GetCustomMD(), exp.ObjAttrs.GetCustomMD()),
+			"%d: attrs %+v != %+v", i, hdr.ObjAttrs, exp.ObjAttrs)
+		tassert.Errorf(t, hdr.Seq == exp.Seq, "%d: seq %d != %d", i, hdr.Seq, exp.Seq)
+		tassert.Errorf(t, bytes.Equal(payloads[i], data[i]), "%d: payload mismatch", i)
+	}
+}
+
+func Test_Trailer(t *testing.T) {
+	for _, usePDU := range []bool{false, true} {
+		t.Run("pdu="+strconv.FormatBool(usePDU), func(t *testing.T) { testTrailer(t, usePDU, false) })
+		// the callback reads exactly the object size (and not a byte more) and frees the reader
+		t.Run("read-full/pdu="+strconv.FormatBool(usePDU), func(t *testing.T) { testTrailer(t, usePDU, true) })
+	}
+}
+
+func testTrailer(t *testing.T, usePDU, readFull bool) {
+	const numObjs = 50
+	var (
+		numTrailers atomic.Int64
+		numRecv     atomic.Int64
+		trname      = "trailer-" + strconv.FormatBool(usePDU) + "-" + strconv.FormatBool(readFull)
+		recvFunc    = func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+			cos.Assert(err == nil || cos.IsEOF(err))
+			cksum := cos.NewCksumHash(cos.ChecksumXXHash)
+			if readFull {
+				defer transport.FreeRecv(objReader)
+				size := hdr.ObjAttrs.Size
+				if hdr.Gzip {
+					size = hdr.Usize // (the rest of the gzip stream remains unread)
+				}
+				buf := make([]byte, size)
+				_, err := io.ReadFull(objReader, buf)
+				tassert.CheckFatal(t, err)
+				cksum.H.Write(buf)
+				numRecv.Inc()
+				if tr := transport.GetTrailer(objReader); tr != nil && !hdr.Gzip {
+					cksum.Finalize()
+					tassert.Errorf(t, cksum.Equal(tr.Cksum), "%s: checksum %s != %s", hdr.ObjName, cksum.Clone(), tr.Cksum)
+				}
+				return nil
+			}
+			written, err := io.Copy(cksum.H, objReader)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, written == hdr.ObjAttrs.Size, "size %d != %d", written, hdr.ObjAttrs.Size)
+			numRecv.Inc()
+
+			tr := transport.GetTrailer(objReader)
+			if hdr.IsHeaderOnly() || hdr.Opaque[0]%2 != 0 {
+				tassert.Errorf(t, tr == nil, "%s: unexpected trailer", hdr.ObjName)
+				return nil
+			}
+			tassert.Fatalf(t, tr != nil, "%s: missing trailer", hdr.ObjName)
+			cksum.Finalize()
+			tassert.Errorf(t, cksum.Equal(tr.Cksum), "%s: checksum %s != %s", hdr.ObjName, cksum.Clone(), tr.Cksum)
+			tassert.Errorf(t, tr.Status == int64(hdr.Opaque[0]), "%s: status %d", hdr.ObjName, tr.Status)
+			numTrailers.Inc()
+			return nil
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	url := ts.URL + transport.ObjURLPath(trname)
+	extra := &transport.Extra{}
+	if usePDU {
+		extra.SizePDU = memsys.DefaultBufSize
+	}
+	stream := transport.NewObjStream(httpclient, url, cos.GenTie(), extra)
+
+	var (
+		random       = newRand(mono.NanoTime())
+		slab, _      = memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+		numExpected  int64
+		withTrailers int64
+	)
+	for i := 0; i < numObjs; i++ {
+		hdr := genStaticHeader(random)
+		hdr.Opaque = []byte{byte(i)}
+		hdr.ObjAttrs.Size = int64(random.Intn(256*cos.KiB) + 1)
+		if i%5 == 0 {
+			hdr.ObjAttrs.Size = 0 // header-only: never followed by trailer
+		}
+		obj := &transport.Obj{Hdr: hdr}
+		if hdr.ObjAttrs.Size != 0 {
+			var reader io.ReadCloser = newRandReader(random, hdr, slab)
+			if readFull && i%3 == 0 {
+				var zb bytes.Buffer
+				zw := gzip.NewWriter(&zb)
+				_, err := io.Copy(zw, reader)
+				tassert.CheckFatal(t, err)
+				// trailing empty blocks that the receiver's gzip reader gets to only upon reading
+				// past the decompressed size - which the callback never does
+				for j := 0; j < 4096; j++ {
+					tassert.CheckFatal(t, zw.Flush())
+				}
+				tassert.CheckFatal(t, zw.Close())
+				obj.Hdr.Gzip, obj.Hdr.Usize = true, hdr.ObjAttrs.Size
+				obj.Hdr.ObjAttrs.Size = int64(zb.Len())
+				reader = io.NopCloser(&zb)
+			}
+			cr := &cksumReader{ReadCloser: reader, cksum: cos.NewCksumHash(cos.ChecksumXXHash)}
+			obj.Reader = cr
+			if i%2 == 0 {
+				obj.Trailer = func(hdr transport.ObjHdr, _ int64) transport.ObjTrailer {
+					cr.cksum.Finalize()
+					return transport.ObjTrailer{Cksum: cr.cksum.Clone(), Status: int64(hdr.Opaque[0])}
+				}
+				withTrailers++
+			}
+		} else {
+			obj.Trailer = func(transport.ObjHdr, int64) transport.ObjTrailer { panic("header-only") }
+		}
+		numExpected++
+		stream.Send(obj)
+	}
+	stream.Fin()
+
+	tassert.Errorf(t, numRecv.Load() == numExpected, "received %d objects, expected %d", numRecv.Load(), numExpected)
+	if !readFull {
+		tassert.Errorf(t, numTrailers.Load() == withTrailers, "received %d trailers, expected %d",
+			numTrailers.Load(), withTrailers)
+	}
+}
+
+// typed opaque (see transport.PackCodec)
+type testOpaque struct {
+	name string
+	id   int64
+}
+
+func (o *testOpaque) PackedSize() int { return cos.SizeofI64 + cos.SizeofLen + len(o.name) }
+
+func (o *testOpaque) Pack(packer *cos.BytePack) {
+	packer.WriteInt64(o.id)
+	packer.WriteString(o.name)
+}
+
+func (o *testOpaque) Unpack(unpacker *cos.ByteUnpack) (err error) {
+	if o.id, err = unpacker.ReadInt64(); err != nil {
+		return
+	}
+	o.name, err = unpacker.ReadString()
+	return
+}
+
+func Test_PackCodec(t *testing.T) {
+	codec := &transport.PackCodec{New: func() cos.Unpacker { return &testOpaque{} }}
+	b, err := codec.Encode(&testOpaque{id: 42, name: "forty-two"})
+	tassert.CheckFatal(t, err)
+	v, err := codec.Decode(b)
+	tassert.CheckFatal(t, err)
+	o := v.(*testOpaque)
+	tassert.Errorf(t, o.id == 42 && o.name == "forty-two", "unexpected %+v", o)
+
+	_, err = codec.Encode("not a packer")
+	tassert.Errorf(t, err != nil, "expecting encode to fail")
+	_, err = codec.Decode(b[:len(b)-1])
+	tassert.Errorf(t, err != nil, "expecting decode to fail")
+}
+
+func Test_OpaqueCodec(t *testing.T) {
+	const numObjs = 50
+	var (
+		numRecv  atomic.Int64
+		trname   = "opaque-codec"
+		codec    = &transport.PackCodec{New: func() cos.Unpacker { return &testOpaque{} }}
+		recvFunc = func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+			cos.Assert(err == nil || cos.IsEOF(err))
+			written, _ := io.Copy(io.Discard, objReader)
+			tassert.Fatalf(t, written == hdr.ObjAttrs.Size, "size %d != %d", written, hdr.ObjAttrs.Size)
+			o, ok := hdr.OpaqueV.(*testOpaque)
+			tassert.Fatalf(t, ok, "%s: expecting decoded opaque, got %T", hdr.ObjName, hdr.OpaqueV)
+			tassert.Errorf(t, o.name == hdr.ObjName, "%s: opaque name %q", hdr.ObjName, o.name)
+			tassert.Errorf(t, o.id == hdr.ObjAttrs.Size, "%s: opaque id %d != %d", hdr.ObjName, o.id, hdr.ObjAttrs.Size)
+			numRecv.Inc()
+			return nil
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc, &transport.RxExtra{Codec: codec})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	url := ts.URL + transport.ObjURLPath(trname)
+	stream := transport.NewObjStream(httpclient, url, cos.GenTie(), &transport.Extra{Codec: codec})
+
+	random := newRand(mono.NanoTime())
+	slab, _ := memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+	for i := 0; i < numObjs; i++ {
+		hdr := genStaticHeader(random)
+		hdr.Opaque = nil
+		hdr.ObjName = "obj-" + strconv.Itoa(i)
+		if i%5 == 0 {
+			hdr.ObjAttrs.Size = 0
+		} else {
+			hdr.ObjAttrs.Size = int64(random.Intn(64*cos.KiB) + 1)
+		}
+		if i == numObjs-1 {
+			// raw opaque takes precedence over the typed one
+			o := &testOpaque{id: hdr.ObjAttrs.Size, name: hdr.ObjName}
+			b, err := codec.Encode(o)
+			tassert.CheckFatal(t, err)
+			hdr.Opaque = b
+			hdr.OpaqueV = &testOpaque{name: "ignored"}
+		} else {
+			hdr.OpaqueV = &testOpaque{id: hdr.ObjAttrs.Size, name: hdr.ObjName}
+		}
+		obj := &transport.Obj{Hdr: hdr}
+		if hdr.ObjAttrs.Size != 0 {
+			obj.Reader = newRandReader(random, hdr, slab)
+		}
+		stream.Send(obj)
+	}
+	stream.Fin()
+
+	tassert.Errorf(t, numRecv.Load() == numObjs, "received %d objects, expected %d", numRecv.Load(), numObjs)
+}
+
+func Test_RxPeer(t *testing.T) {
+	const (
+		numObjs  = 16
+		callerID = "t[caller]"
+	)
+	var (
+		numRecv  atomic.Int64
+		mu       sync.Mutex
+		peers    = make(map[transport.Peer]int, 2)
+		trname   = "rx-peer"
+		recvFunc = func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+			cos.Assert(err == nil || cos.IsEOF(err))
+			peer := transport.GetPeer(objReader)
+			host, _, errSplit := net.SplitHostPort(peer.Addr)
+			tassert.Errorf(t, errSplit == nil && host == "127.0.0.1", "%s: unexpected peer address %q", hdr.ObjName, peer.Addr)
+			tassert.Errorf(t, peer.ID == hdr.SID, "%s: peer ID %q != %q", hdr.ObjName, peer.ID, hdr.SID)
+			written, _ := io.Copy(io.Discard, objReader)
+			tassert.Errorf(t, written == hdr.ObjAttrs.Size, "size %d != %d", written, hdr.ObjAttrs.Size)
+			mu.Lock()
+			peers[peer]++
+			mu.Unlock()
+			numRecv.Inc()
+			return nil
+		}
+	)
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, recvFunc)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	url := ts.URL + transport.ObjURLPath(trname)
+	random := newRand(mono.NanoTime())
+	slab, _ := memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+
+	// with and without caller ID
+	for _, sid := range []string{callerID, ""} {
+		stream := transport.NewObjStream(httpclient, url, cos.GenTie(), &transport.Extra{CallerID: sid})
+		for i := 0; i < numObjs; i++ {
+			hdr := genStaticHeader(random)
+			hdr.SID = sid
+			hdr.ObjAttrs.Size = int64(random.Intn(16*cos.KiB) + 1)
+			stream.Send(&transport.Obj{Hdr: hdr, Reader: newRandReader(random, hdr, slab)})
+		}
+		stream.Fin()
+	}
+
+	tassert.Errorf(t, numRecv.Load() == 2*numObjs, "received %d objects, expected %d", numRecv.Load(), 2*numObjs)
+	tassert.Errorf(t, len(peers) == 2, "expecting two distinct peers, got %v", peers)
+}
+
 func receive10G(hdr transport.ObjHdr, objReader io.Reader, err error) error {
 	cos.Assert(err == nil || cos.IsEOF(err))
 	written, _ := io.Copy(io.Discard, objReader)
@@ -827,7 +3273,7 @@ func makeRandReader(random *rand.Rand, usePDU bool) (transport.ObjHdr, *randRead
 	if hdr.ObjSize() == 0 {
 		return hdr, nil
 	}
-	slab, err := memsys.PageMM().GetSlab(memsys.DefaultBufSize)
+	slab, err := randmm.GetSlab(memsys.DefaultBufSize)
 	if err != nil {
 		panic("Failed getting slab: " + err.Error())
 	}
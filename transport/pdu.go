@@ -155,5 +155,17 @@ func fl2s(flags uint64) (s string) {
 	if flags&pduLastFl != 0 {
 		s += "[lst]"
 	}
+	if flags&trailerFl != 0 {
+		s += "[trailer]"
+	}
+	if flags&seqFl != 0 {
+		s += "[seq]"
+	}
+	if flags&ctrlFl != 0 {
+		s += "[ctrl]"
+	}
+	if flags&gzipFl != 0 {
+		s += "[gzip]"
+	}
 	return
 }
@@ -6,6 +6,7 @@
 package transport
 
 import (
+	"compress/gzip"
 	"io"
 	"sync"
 
@@ -59,6 +60,29 @@ func FreeRecv(object io.Reader) {
 	}
 	obj, ok := object.(*objReader)
 	debug.Assert(ok && obj != nil)
+	if obj.inCb {
+		obj.freed = true // (see iterator.deliver)
+		return
+	}
+	if obj.gzr != nil {
+		gzipPool.Put(obj.gzr)
+	}
 	*obj = robj0
 	recvPool.Put(obj)
 }
+
+//////////////
+// gzipPool //
+//////////////
+
+// (see ObjHdr.Gzip)
+var gzipPool sync.Pool
+
+func allocGzip() (gzr *gzip.Reader) {
+	if v := gzipPool.Get(); v != nil {
+		gzr = v.(*gzip.Reader)
+	} else {
+		gzr = &gzip.Reader{}
+	}
+	return
+}
@@ -6,10 +6,16 @@
 package transport
 
 import (
+	"bufio"
+	"compress/gzip"
+	"errors"
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
 	"path"
 	"runtime"
 	"strconv"
@@ -38,34 +44,117 @@ type (
 		handler *handler
 		pdu     *rpdu
 		stats   *Stats
+		dlr     *dlReader // when RxExtra.ReadTimeout is specified, wraps the body for all in-object reads
+		peer    *Peer
 		hbuf    []byte
+		aerr    cos.ErrValue   // first error returned by an asynchronous callback (see RxExtra.Workers)
+		wg      sync.WaitGroup // asynchronous callbacks in progress
+		sessID  int64
+		mark    int64 // mono time of the last lap (see RxExtra.NoTiming)
+		fin     bool  // received the last marker (see RxEndCB)
+		json    bool  // JSON-encoded object headers (see jsonhdr.go)
+		timed   bool  // !RxExtra.NoTiming
+	}
+	// enforces RxExtra.ReadTimeout by setting (and clearing) the connection's read deadline
+	// around each in-object read
+	dlReader struct {
+		r       io.Reader
+		conn    readDeadliner
+		err     error // sticky, once timed out
+		timeout time.Duration
+	}
+	// (compare with http.ResponseController - go1.20)
+	readDeadliner interface {
+		SetReadDeadline(deadline time.Time) error
+	}
+	// RxExtra.ReadSize: reads from the network in chunks; unlike bufio.Reader, never returns
+	// less than requested short of an error (the receive path relies on full-length reads)
+	chunkReader struct {
+		br *bufio.Reader
 	}
 	objReader struct {
-		body   io.Reader
-		pdu    *rpdu
-		loghdr string
-		hdr    ObjHdr
-		off    int64
+		body    io.Reader
+		pdu     *rpdu
+		h       *handler
+		trailer *ObjTrailer    // received upon reading the payload (see GetTrailer)
+		peer    *Peer          // (see GetPeer)
+		stats   *Stats         // session stats (to update the `Pending` gauge)
+		sgl     *memsys.SGL    // buffered payload (see RxExtra.Workers)
+		gzr     *gzip.Reader   // decompresses the payload (see ObjHdr.Gzip)
+		cksum   *cos.CksumHash // running checksum of the payload (see RxExtra.VerifyCksum)
+		rbuf    []byte         // payload read buffer (see RxExtra.BufPool)
+		loghdr  string
+		hdr     ObjHdr
+		err     error // sticky: the payload exceeds RxExtra.MaxObjSize (and the stream is broken)
+		off     int64
+		nextcb  int64 // next offset to call RxProgress
+		hasTr   bool  // trailer follows the payload
+		eof     bool  // fully read by the callback (and `cksum`, if any, finalized)
+		inCb    bool  // in the (inline) RecvObj callback - FreeRecv gets deferred until it returns
+		freed   bool  // FreeRecv called by the callback
+	}
+	// reads the payload as is - compressed or not (compare with objReader.Read)
+	rawReader struct {
+		obj *objReader
 	}
 	handler struct {
 		rxObj       RecvObj
 		rxMsg       RecvMsg
+		extra       RxExtra
+		wsema       *cos.Semaphore // bounds asynchronous callbacks (see RxExtra.Workers)
 		sessions    sync.Map
 		oldSessions sync.Map
 		hkName      string
 		trname      string
 		now         int64
+		eps         map[string]*rxEndpoint // by network (see cmn.KnownNetworks); immutable once registered
+	}
+	// receive-side state of a given (network, trname) endpoint
+	rxEndpoint struct {
+		accepted  atomic.Int64 // streams accepted so far
+		throttled atomic.Int64 // streams rejected due to RxExtra.AcceptRate
+		active    atomic.Int64 // currently active streams
+		peak      atomic.Int64 // max active so far
+		busy      atomic.Int64 // streams rejected due to config.Transport.MaxRxStreams
+		amu       sync.Mutex   // protects the token bucket (below)
+		tokens    float64      // RxExtra.AcceptRate: available tokens
+		tlast     int64        // RxExtra.AcceptRate: last refill (mono time)
+		admitted  sync.Map     // RxExtra.AcceptRate: sessions that took their token upon pre-flight (see sessKey)
+		paused    atomic.Bool  // not accepting new streams (see PauseHandler)
 	}
 
 	ErrDuplicateTrname struct {
 		trname string
 	}
+	// Rx: invalid endpoint registration (see HandleObjStream, HandleMsgStream)
+	ErrInvalidHandler struct {
+		trname string
+		reason string
+	}
+	ErrReadTimeout struct {
+		timeout time.Duration
+	}
+	// Tx: receiver is over its limit of active streams (see config.Transport.MaxRxStreams)
+	// or is paused (see PauseHandler) - returned upon pre-flight, prior to sending anything,
+	// or when the receiver rejects the stream itself
+	ErrRxBusy struct {
+		dstURL string
+	}
+)
+
+var (
+	rxActive      map[string]*atomic.Int64 // active receive streams by network (see config.Transport.MaxRxStreams)
+	nextSessionID atomic.Int64             // next unique session ID
+	handlers      map[string]*handler      // by trname
+	mu            *sync.RWMutex            // ptotect handlers
 )
 
+// returned by the object reader in place of io.EOF when the received payload does not match
+// the object's header-declared size - e.g., upon a dropped connection; receivers must reject
+// (ie., not persist) such objects
 var (
-	nextSessionID atomic.Int64        // next unique session ID
-	handlers      map[string]*handler // by trname
-	mu            *sync.RWMutex       // ptotect handlers
+	ErrShortObject = errors.New("short object")
+	ErrLongObject  = errors.New("object exceeds its declared size")
 )
 
 // main Rx objects
@@ -74,13 +163,14 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 		reader    io.Reader = r.Body
 		lz4Reader *lz4.Reader
 		trname    = path.Base(r.URL.Path)
+		network   = r.Header.Get(apc.HdrSessNet)
 	)
 	mu.RLock()
 	h, ok := handlers[trname]
 	if !ok {
 		mu.RUnlock()
 		err := cmn.NewErrNotFound("unknown transport endpoint %q", trname)
-		if verbose {
+		if verbose.Load() {
 			cmn.WriteErr(w, r, err, 0)
 		} else {
 			cmn.WriteErr(w, r, err, 0, 1 /*silent*/)
@@ -88,10 +178,61 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	mu.RUnlock()
-	// compression
+	if network == "" {
+		network = cmn.NetIntraData // (see Extra.Network)
+	}
+	ep, ok := h.eps[network]
+	if !ok {
+		cmn.WriteErr(w, r, fmt.Errorf("%s: unknown network %q", trname, network))
+		return
+	}
+
+	limit := cmn.GCO.Get().Transport.MaxRxStreams
+	if h.limited(ep, limit) {
+		w.Header().Set(apc.HdrRxLimited, "true") // tells the sender to pre-flight its next session
+	}
+
+	// pre-flight: admit or reject (retryable) prior to receiving anything
+	if r.Method == http.MethodHead {
+		h.preflight(w, r, ep, network, limit)
+		return
+	}
+	// same checks for the streams that do not pre-flight (e.g., StreamWriter, JSON headers, older peers)
+	if ep.paused.Load() {
+		rejectBusy(w, r, fmt.Errorf("%s(%s): paused, not accepting new streams", trname, network))
+		return
+	}
+
+	// active streams
+	if n := rxActive[network].Inc(); limit > 0 && n > int64(limit) {
+		rxActive[network].Dec()
+		ep.busy.Inc()
+		rejectBusy(w, r, fmt.Errorf("%s(%s): too many active streams (max %d)", trname, network, limit))
+		return
+	}
+	// accept rate (the token may have already been taken upon pre-flight)
+	if _, ok := ep.admitted.LoadAndDelete(sessKey(r)); !ok && !ep.admit(h.extra.AcceptRate) {
+		rxActive[network].Dec()
+		ep.throttled.Inc()
+		rejectBusy(w, r, fmt.Errorf("%s(%s): too many new streams (max %d/s)", trname, network, h.extra.AcceptRate))
+		return
+	}
+	defer rxActive[network].Dec()
+	ep.accepted.Inc()
+	n := ep.active.Inc()
+	defer ep.active.Dec()
+	for peak := ep.peak.Load(); n > peak && !ep.peak.CAS(peak, n); peak = ep.peak.Load() {
+	}
+
+	// read granularity
+	if h.extra.ReadSize > 0 {
+		reader = &chunkReader{br: bufio.NewReaderSize(reader, h.extra.ReadSize)}
+	}
+
+	// compression (decompressing what's been read from the network - in chunks, if so configured)
 	if compressionType := r.Header.Get(apc.HdrCompress); compressionType != "" {
 		debug.Assert(compressionType == apc.LZ4Compression)
-		lz4Reader = lz4.NewReader(r.Body)
+		lz4Reader = lz4.NewReader(reader)
 		reader = lz4Reader
 	}
 
@@ -102,17 +243,25 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	uid := uniqueID(r, sessID)
-	statsif, _ := h.sessions.LoadOrStore(uid, &Stats{})
+	statsif, _ := h.sessions.LoadOrStore(uid, newRxStats(network))
 	xxh, _ := UID2SessID(uid)
 	loghdr := fmt.Sprintf("%s[%d:%d]", trname, xxh, sessID)
-	if verbose {
+	if verbose.Load() {
 		glog.Infof("%s: start-of-stream from %s", loghdr, r.RemoteAddr)
 	}
 	stats := statsif.(*Stats)
 
 	// receive loop
 	mm := memsys.PageMM()
-	it := &iterator{handler: h, body: reader, stats: stats}
+	peer := &Peer{Addr: r.RemoteAddr, ID: r.Header.Get(apc.HdrCallerID)}
+	it := &iterator{handler: h, body: reader, stats: stats, peer: peer, sessID: sessID, timed: !h.extra.NoTiming}
+	if h.extra.ReadTimeout > 0 {
+		if conn := rxDeadliner(w); conn != nil {
+			it.dlr = &dlReader{r: reader, conn: conn, timeout: h.extra.ReadTimeout}
+		} else {
+			glog.Warningf("%s: read timeout not supported (%T)", loghdr, w)
+		}
+	}
 	it.hbuf, _ = mm.AllocSize(dfltMaxHdr)
 	err = it.rxloop(uid, loghdr, mm)
 
@@ -125,6 +274,17 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 	}
 	mm.Free(it.hbuf)
 
+	if h.extra.OnEnd != nil {
+		h.extra.OnEnd(sessID, it.endErr(err))
+	}
+
+	// flow control (must precede writing the response)
+	if h.extra.MaxRate != nil {
+		if rate := h.extra.MaxRate(trname, sessID, stats.snap()); rate > 0 {
+			w.Header().Set(apc.HdrRxMaxRate, strconv.FormatInt(rate, 10))
+		}
+	}
+
 	// if err != io.EOF {
 	if !cos.IsEOF(err) {
 		cmn.WriteErr(w, r, err)
@@ -136,6 +296,13 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 ////////////////
 
 func (h *handler) handle() error {
+	if err := h.validate(); err != nil {
+		return err
+	}
+	h.eps = make(map[string]*rxEndpoint, len(cmn.KnownNetworks))
+	for _, network := range cmn.KnownNetworks {
+		h.eps[network] = &rxEndpoint{}
+	}
 	mu.Lock()
 	if _, ok := handlers[h.trname]; ok {
 		mu.Unlock()
@@ -147,38 +314,180 @@ func (h *handler) handle() error {
 	return nil
 }
 
+func (h *handler) endpoint() string {
+	if h.rxObj != nil {
+		return apc.ObjStream
+	}
+	return apc.MsgStream
+}
+
+// fail upon registration rather than upon receiving the first object
+// (and with a panic, in a different goroutine)
+func (h *handler) validate() error {
+	switch {
+	case h.trname == "":
+		return &ErrInvalidHandler{h.trname, "empty trname"}
+	case h.trname == "." || h.trname == ".." || url.PathEscape(h.trname) != h.trname:
+		return &ErrInvalidHandler{h.trname, "trname is not a valid URL path element"}
+	case h.rxObj == nil && h.rxMsg == nil:
+		return &ErrInvalidHandler{h.trname, "nil receive callback"}
+	}
+	return nil
+}
+
+// whether the endpoint may reject new streams (see apc.HdrRxLimited)
+func (h *handler) limited(ep *rxEndpoint, limit int) bool {
+	return limit > 0 || h.extra.AcceptRate > 0 || ep.paused.Load()
+}
+
+// pre-flight (bodyless) request that precedes sender's session when the receiver may reject it (see
+// streamBase.needPreflight): new streams get rejected here, before any objects are sent, so that senders
+// can back off and retry without losing anything; the check is advisory - the PUT that follows
+// gets checked again (and may still be rejected when concurrent streams take up the slack) - except
+// for the accept-rate token that, once taken, carries over to the same session's PUT
+func (h *handler) preflight(w http.ResponseWriter, r *http.Request, ep *rxEndpoint, network string, limit int) {
+	var err error
+	switch {
+	case ep.paused.Load():
+		// in-flight streams keep going while new ones get rejected
+		err = fmt.Errorf("%s(%s): paused, not accepting new streams", h.trname, network)
+	case limit > 0 && rxActive[network].Load() >= int64(limit):
+		ep.busy.Inc()
+		err = fmt.Errorf("%s(%s): too many active streams (max %d)", h.trname, network, limit)
+	case !ep.admit(h.extra.AcceptRate):
+		ep.throttled.Inc()
+		err = fmt.Errorf("%s(%s): too many new streams (max %d/s)", h.trname, network, h.extra.AcceptRate)
+	}
+	if err != nil {
+		rejectBusy(w, r, err)
+		return
+	}
+	if h.extra.AcceptRate > 0 && r.Header.Get(apc.HdrSessID) != "" {
+		ep.admitted.Store(sessKey(r), mono.NanoTime())
+	}
+}
+
+// identifies sender's session across requests - pre-flight and the PUT that follows may come
+// over different connections (compare with uniqueID)
+func sessKey(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return host + "/" + r.Header.Get(apc.HdrSessID)
+}
+
+// reject new stream with a retryable 503 (see ErrRxBusy)
+func rejectBusy(w http.ResponseWriter, r *http.Request, err error) {
+	w.Header().Set(cos.HdrRetryAfter, strconv.Itoa(int(connErrWait/time.Second)))
+	cmn.WriteErr(w, r, err, http.StatusServiceUnavailable, 1 /*silent*/)
+}
+
+// RxExtra.AcceptRate: per (network, trname) token bucket that refills at the configured rate
+// and holds up to one second worth of tokens
+func (ep *rxEndpoint) admit(rate int) bool {
+	if rate <= 0 {
+		return true
+	}
+	now := mono.NanoTime()
+	ep.amu.Lock()
+	if ep.tlast == 0 {
+		ep.tokens = float64(rate)
+	} else {
+		ep.tokens += float64(rate) * time.Duration(now-ep.tlast).Seconds()
+		if ep.tokens > float64(rate) {
+			ep.tokens = float64(rate)
+		}
+	}
+	ep.tlast = now
+	ok := ep.tokens >= 1
+	if ok {
+		ep.tokens--
+	}
+	ep.amu.Unlock()
+	return ok
+}
+
 func (h *handler) cleanup() time.Duration {
 	h.now = mono.NanoTime()
 	h.oldSessions.Range(h.cl)
+	for _, ep := range h.eps {
+		ep.cleanup(h.now)
+	}
 	return sessionIsOld
 }
 
+// forget pre-flights that were never followed by PUT
+func (ep *rxEndpoint) cleanup(now int64) {
+	ep.admitted.Range(func(key, value any) bool {
+		if time.Duration(now-value.(int64)) > sessionIsOld {
+			ep.admitted.Delete(key)
+		}
+		return true
+	})
+}
+
 func (h *handler) cl(key, value any) bool {
 	timeClosed := value.(int64)
 	if time.Duration(h.now-timeClosed) > sessionIsOld {
-		uid := key.(uint64)
-		h.oldSessions.Delete(uid)
-		h.sessions.Delete(uid)
+		h.reap(key.(uint64))
 	}
 	return true
 }
 
+// upon Unhandle
+func (h *handler) reapAll(key, _ any) bool {
+	h.reap(key.(uint64))
+	return true
+}
+
+func (h *handler) reap(uid uint64) {
+	h.oldSessions.Delete(uid)
+	if v, ok := h.sessions.LoadAndDelete(uid); ok {
+		h.sessClosed(uid, v.(*Stats))
+	}
+}
+
+// RxExtra.OnSessionClose
+func (h *handler) sessClosed(uid uint64, stats *Stats) {
+	if h.extra.OnSessionClose == nil {
+		return
+	}
+	_, sessID := UID2SessID(uid)
+	h.extra.OnSessionClose(h.trname, int64(sessID), stats.snap())
+}
+
 //////////////////////////////////
 // next(obj, msg, pdu) iterator //
 //////////////////////////////////
 
 func (it *iterator) Read(p []byte) (n int, err error) { return it.body.Read(p) }
 
+// in-object reads (compare with it.Read above)
+func (it *iterator) objBody() io.Reader {
+	if it.dlr != nil {
+		return it.dlr
+	}
+	return it.body
+}
+
 func (it *iterator) rxloop(uid uint64, loghdr string, mm *memsys.MMSA) (err error) {
-	for err == nil {
+	if it.timed {
+		it.mark = mono.NanoTime()
+	}
+	for first := true; err == nil; first = false {
 		var (
 			flags uint64
 			hlen  int
 		)
-		hlen, flags, err = it.nextProtoHdr(loghdr)
+		hlen, flags, err = it.nextProtoHdr(loghdr, first)
 		if err != nil {
 			break
 		}
+		if it.json {
+			err = it.rxloopJSON(loghdr)
+			break
+		}
 		if hlen > cap(it.hbuf) {
 			if hlen > maxSizeHeader {
 				err = fmt.Errorf("sbr1 %s: hlen %d exceeds maximum %d", loghdr, hlen, maxSizeHeader)
@@ -190,41 +499,107 @@ func (it *iterator) rxloop(uid uint64, loghdr string, mm *memsys.MMSA) (err erro
 			it.hbuf, _ = mm.AllocSize(cos.MinI64(int64(hlen)<<1, maxSizeHeader))
 		}
 		_ = it.stats.Offset.Add(int64(hlen + sizeProtoHdr))
+		if flags&ctrlFl != 0 {
+			err = it.rxCtrl(loghdr, hlen)
+			it.lap(&it.stats.HdrTime)
+			continue
+		}
 		if flags&msgFl == 0 {
 			if flags&pduStreamFl != 0 {
 				if it.pdu == nil {
 					pbuf, _ := mm.AllocSize(maxSizePDU)
-					it.pdu = newRecvPDU(it.body, pbuf)
+					it.pdu = newRecvPDU(it.objBody(), pbuf)
 				} else {
 					it.pdu.reset()
 				}
 			}
-			err = it.rxObj(loghdr, hlen)
+			err = it.rxObj(loghdr, hlen, flags)
 		} else {
 			err = it.rxMsg(loghdr, hlen)
 		}
 	}
+	if it.handler.wsema != nil {
+		it.wg.Wait()
+		if errCb := it.aerr.Err(); errCb != nil && (err == nil || cos.IsEOF(err)) {
+			err = errCb
+		}
+	}
+	if err != nil && !cos.IsEOF(err) {
+		it.stats.Rejected.Inc()
+	}
 	h := it.handler
 	h.oldSessions.Store(uid, mono.NanoTime())
+	h.sessClosed(uid, it.stats)
 	return
 }
 
-func (it *iterator) rxObj(loghdr string, hlen int) (err error) {
-	var obj *objReader
+func (it *iterator) rxObj(loghdr string, hlen int, flags uint64) error {
+	obj, err := it.nextObj(loghdr, hlen, flags)
+	it.lap(&it.stats.HdrTime)
+	err = it.deliver(loghdr, obj, err)
+	it.lap(&it.stats.PayloadTime)
+	return err
+}
+
+// add the time elapsed since the previous lap to the given (header or payload) time
+func (it *iterator) lap(total *atomic.Int64) {
+	if !it.timed {
+		return
+	}
+	now := mono.NanoTime()
+	total.Add(now - it.mark)
+	it.mark = now
+}
+
+// common for both binary and JSON headers: decode opaque, skip, or call RecvObj; update stats
+func (it *iterator) deliver(loghdr string, obj *objReader, err error) error {
 	h := it.handler
-	obj, err = it.nextObj(loghdr, hlen)
 	if obj != nil {
 		if !obj.hdr.IsHeaderOnly() {
 			obj.pdu = it.pdu
 		}
+		if maxSize := h.extra.MaxObjSize; maxSize > 0 && obj.Size() > maxSize {
+			// never hand it over to the callback, and never read the payload
+			it.stats.Oversized.Inc()
+			it.stats.Dropped.Inc()
+			err = fmt.Errorf("sbr18 %s: %s exceeds the maximum object size %d", loghdr, obj, maxSize)
+			FreeRecv(obj)
+			return err
+		}
+		obj.stats = it.stats
+		obj.setPending()
 		err = eofOK(err)
+		if err == nil && h.extra.Codec != nil && len(obj.hdr.Opaque) > 0 {
+			if obj.hdr.OpaqueV, err = h.extra.Codec.Decode(obj.hdr.Opaque); err != nil {
+				err = fmt.Errorf("sbr13 %s: failed to decode opaque, err %w", loghdr, err)
+			}
+		}
+		if err == nil && h.extra.Skip != nil && h.extra.Skip(obj.hdr) {
+			return it.skipObj(obj)
+		}
+		if err == nil && h.wsema != nil {
+			return it.deliverAsync(loghdr, obj)
+		}
 		size, off := obj.hdr.ObjAttrs.Size, obj.off
-		if errCb := h.rxObj(obj.hdr, obj, err); errCb != nil {
+		obj.inCb = true
+		if errCb := h.recv(obj, err); errCb != nil {
 			err = errCb
 		}
+		obj.inCb = false
+		if obj.err != nil {
+			err = obj.err // regardless of what the callback returns
+		}
+		if err == nil && (!obj.eof || (obj.hasTr && obj.trailer == nil)) {
+			err = obj.drain()
+		}
+		it.stats.Pending.Store(0) // whatever the callback did not read is no longer pending
+		if it.dlr != nil && it.dlr.err != nil {
+			// regardless of what the callback returns, the stream is broken
+			err = fmt.Errorf("sbr11 %s: %s, err %w", loghdr, obj, it.dlr.err)
+		}
 		// stats
 		if err == nil {
-			it.stats.Num.Inc()              // this stream stats
+			it.stats.rxed()                 // this stream stats
 			statsTracker.Add(InObjCount, 1) // stats/target_stats.go
 			if size >= 0 {
 				statsTracker.Add(InObjSize, size)
@@ -232,12 +607,97 @@ func (it *iterator) rxObj(loghdr string, hlen int) (err error) {
 				debug.Assert(size == SizeUnknown)
 				statsTracker.Add(InObjSize, obj.off-off)
 			}
+		} else {
+			it.stats.Dropped.Inc()
+		}
+		if obj.freed {
+			FreeRecv(obj)
 		}
 	} else if err != nil && err != io.EOF {
+		it.stats.Dropped.Inc()
 		if errCb := h.rxObj(ObjHdr{}, nil, err); errCb != nil {
 			err = errCb
 		}
 	}
+	return err
+}
+
+// call RecvObj with a payload read buffer from the pool, if configured (see RxExtra.BufPool)
+func (h *handler) recv(obj *objReader, err error) error {
+	pool := h.extra.BufPool
+	if pool == nil || err != nil || obj.hdr.IsHeaderOnly() {
+		return h.rxObj(obj.hdr, obj, err)
+	}
+	rbuf, _ := pool.AllocSize(obj.rbufSize())
+	obj.rbuf = rbuf
+	err = h.rxObj(obj.hdr, obj, nil)
+	pool.Free(rbuf) // (the callback may have already freed the objReader - see FreeRecv)
+	return err
+}
+
+// RxExtra.Workers: read the payload into memory and update stats - both in the receive order -
+// and then run the callback on a separate goroutine; the semaphore, acquired prior to reading,
+// bounds the number of buffered objects (and provides backpressure to the sender)
+func (it *iterator) deliverAsync(loghdr string, obj *objReader) error {
+	if err := it.aerr.Err(); err != nil {
+		FreeRecv(obj)
+		return err // one of the previous callbacks failed - terminating, same as inline
+	}
+	h := it.handler
+	h.wsema.Acquire()
+	sgl := memsys.PageMM().NewSGL(cos.MaxI64(obj.Size(), 0))
+	_, err := io.Copy(sgl, rawReader{obj}) // (decompressed, if need be, upon reading from sgl)
+	if err == nil && it.dlr != nil && it.dlr.err != nil {
+		err = it.dlr.err
+	}
+	if err != nil {
+		sgl.Free()
+		h.wsema.Release()
+		it.stats.Dropped.Inc()
+		err = fmt.Errorf("sbr17 %s: failed to receive %s, err %w", loghdr, obj, err)
+		FreeRecv(obj)
+		return err
+	}
+	it.stats.rxed()
+	statsTracker.Add(InObjCount, 1)
+	statsTracker.Add(InObjSize, obj.Size())
+
+	obj.sgl = sgl
+	it.wg.Add(1)
+	go it.rxAsync(obj, sgl)
+	return nil
+}
+
+func (it *iterator) rxAsync(obj *objReader, sgl *memsys.SGL) {
+	h := it.handler
+	if err := h.recv(obj, nil); err != nil {
+		it.stats.Dropped.Inc()
+		it.aerr.Store(err)
+	}
+	sgl.Free() // (the callback may have already freed the objReader - see FreeRecv)
+	h.wsema.Release()
+	it.wg.Done()
+}
+
+// discard the payload while keeping the stream framing intact (see RxExtra.Skip)
+func (it *iterator) skipObj(obj *objReader) (err error) {
+	if !obj.hdr.IsHeaderOnly() {
+		if obj.pdu != nil {
+			_, err = io.Copy(io.Discard, rawReader{obj})
+		} else if _, err = io.CopyN(io.Discard, obj.body, obj.Size()-obj.off); err == nil && obj.hasTr {
+			err = obj.readTrailer()
+		}
+	}
+	if err != nil {
+		it.stats.Dropped.Inc()
+		err = fmt.Errorf("sbr10 %s: failed to skip %s, err %w", obj.loghdr, obj, err)
+	} else {
+		it.stats.Pending.Store(0)
+		it.stats.rxed()
+		statsTracker.Add(InObjCount, 1)
+		statsTracker.Add(InObjSize, obj.Size())
+	}
+	FreeRecv(obj)
 	return
 }
 
@@ -245,14 +705,29 @@ func (it *iterator) rxMsg(loghdr string, hlen int) (err error) {
 	var msg Msg
 	h := it.handler
 	msg, err = it.nextMsg(loghdr, hlen)
+	it.lap(&it.stats.HdrTime)
 	if err == nil {
+		it.stats.LastActivity.Store(time.Now().UnixNano())
 		err = h.rxMsg(msg, nil)
 	} else if err != io.EOF {
+		it.stats.Dropped.Inc()
 		err = h.rxMsg(Msg{}, err)
 	}
+	it.lap(&it.stats.PayloadTime)
 	return
 }
 
+// (see RxEndCB)
+func (it *iterator) endErr(err error) error {
+	if err != io.EOF {
+		return err
+	}
+	if it.fin {
+		return nil
+	}
+	return io.ErrUnexpectedEOF
+}
+
 func eofOK(err error) error {
 	if err == io.EOF {
 		err = nil
@@ -262,7 +737,8 @@ func eofOK(err error) error {
 
 // nextProtoHdr receives and handles 16 bytes of the protocol header (not to confuse with transport.Obj.Hdr)
 // returns hlen, which is header length - for transport.Obj, and message length - for transport.Msg
-func (it *iterator) nextProtoHdr(loghdr string) (hlen int, flags uint64, err error) {
+// the very first one may instead be the JSON preamble (see jsonhdr.go)
+func (it *iterator) nextProtoHdr(loghdr string, first bool) (hlen int, flags uint64, err error) {
 	var n int
 	n, err = it.Read(it.hbuf[:sizeProtoHdr])
 	if n < sizeProtoHdr {
@@ -271,12 +747,29 @@ func (it *iterator) nextProtoHdr(loghdr string) (hlen int, flags uint64, err err
 		}
 		return
 	}
+	if first && isJSONPreamble(it.hbuf) {
+		it.json = true
+		return
+	}
 	// extract and validate hlen
 	hlen, flags, err = extProtoHdr(it.hbuf, loghdr)
 	return
 }
 
-func (it *iterator) nextObj(loghdr string, hlen int) (obj *objReader, err error) {
+// sideband control frame: header only (see Stream.SendCtrl)
+func (it *iterator) rxCtrl(loghdr string, hlen int) error {
+	if err := it.readHdr(loghdr, hlen); err != nil {
+		return err
+	}
+	hdr := ExtObjHeader(it.hbuf, hlen)
+	debug.Assert(hdr.isCtrl(), loghdr, " opcode ", hdr.Opcode)
+	if cb := it.handler.extra.OnControl; cb != nil {
+		cb(it.sessID, hdr.Opaque)
+	}
+	return nil
+}
+
+func (it *iterator) readHdr(loghdr string, hlen int) (err error) {
 	var n int
 	n, err = it.Read(it.hbuf[:hlen])
 	if n < hlen {
@@ -300,13 +793,25 @@ func (it *iterator) nextObj(loghdr string, hlen int) (obj *objReader, err error)
 			return
 		}
 	}
+	return nil // (io.EOF, if any, will be returned by the next read)
+}
+
+func (it *iterator) nextObj(loghdr string, hlen int, flags uint64) (obj *objReader, err error) {
+	if err = it.readHdr(loghdr, hlen); err != nil {
+		return
+	}
 	hdr := ExtObjHeader(it.hbuf, hlen)
+	debug.Assert((flags&seqFl != 0) == (hdr.Seq != 0), loghdr, " seq ", hdr.Seq)
+	debug.Assert((flags&gzipFl != 0) == hdr.Gzip, loghdr, " gzip ", hdr.Gzip)
 	if hdr.isFin() {
+		it.fin = true
 		err = io.EOF
 		return
 	}
 	obj = allocRecv()
-	obj.body, obj.hdr, obj.loghdr = it.body, hdr, loghdr
+	obj.body, obj.hdr, obj.loghdr, obj.h, obj.peer = it.objBody(), hdr, loghdr, it.handler, it.peer
+	obj.hasTr = flags&trailerFl != 0
+	obj.initCksum()
 	return
 }
 
@@ -322,6 +827,7 @@ func (it *iterator) nextMsg(loghdr string, hlen int) (msg Msg, err error) {
 	debug.Assertf(n == hlen, "%d != %d", n, hlen)
 	msg = ExtMsg(it.hbuf, hlen)
 	if msg.isFin() {
+		it.fin = true
 		err = io.EOF
 	}
 	return
@@ -332,16 +838,105 @@ func (it *iterator) nextMsg(loghdr string, hlen int) (msg Msg, err error) {
 ///////////////
 
 func (obj *objReader) Read(b []byte) (n int, err error) {
+	if obj.hdr.Gzip {
+		n, err = obj.readGzip(b)
+	} else {
+		n, err = obj.read(b)
+	}
+	if obj.cksum != nil && !obj.eof {
+		obj.cksum.H.Write(b[:n])
+	}
+	if err == io.EOF && !obj.eof {
+		obj.eof = true
+		if obj.cksum != nil {
+			obj.cksum.Finalize()
+		}
+	}
+	return
+}
+
+// (the header's checksum type is validated - the sender may be running a different version)
+func (obj *objReader) initCksum() {
+	cksum := obj.hdr.ObjAttrs.Cksum
+	if !obj.h.extra.VerifyCksum || cksum.IsEmpty() || cos.ValidateCksumType(cksum.Ty()) != nil {
+		return
+	}
+	obj.cksum = cos.NewCksumHash(cksum.Ty())
+}
+
+// returns the result of verifying the received object against the checksum carried in its
+// header (see RxExtra.VerifyCksum) once the callback has read the object in its entirety:
+// - (true, nil): verified;
+// - (false, nil): nothing to verify - not enabled, or the header carries no checksum;
+// - (false, err): checksum mismatch (see cos.IsErrBadCksum), or not fully read yet
+// Is valid only for the duration of the RecvObj callback.
+func Verified(object io.Reader) (bool, error) {
+	obj, ok := object.(*objReader)
+	if !ok || obj.cksum == nil {
+		return false, nil
+	}
+	if !obj.eof {
+		return false, fmt.Errorf("%s: cannot verify checksum - not fully read yet (%d)", obj, obj.off)
+	}
+	if !obj.cksum.Equal(obj.hdr.ObjAttrs.Cksum) {
+		return false, cos.NewBadDataCksumError(&obj.cksum.Cksum, obj.hdr.ObjAttrs.Cksum, obj.String())
+	}
+	return true, nil
+}
+
+// returns the payload read buffer of the object that's being received (see RxExtra.BufPool),
+// or nil if there's none; the buffer is valid only for the duration of the RecvObj callback
+func GetReadBuf(object io.Reader) []byte {
+	if obj, ok := object.(*objReader); ok {
+		return obj.rbuf
+	}
+	return nil
+}
+
+// fits the object (compressed or unsized objects, and objects larger than the max slab - the max slab)
+func (obj *objReader) rbufSize() int64 {
+	if size := obj.Size(); size > 0 && size < maxSizePDU && !obj.hdr.Gzip {
+		return size
+	}
+	return maxSizePDU
+}
+
+// returns the running checksum of the object that's being received (see RxExtra.VerifyCksum),
+// or nil if there's none; the checksum gets finalized (has value) upon reading the object in full
+func GetCksum(object io.Reader) *cos.CksumHash {
+	if obj, ok := object.(*objReader); ok {
+		return obj.cksum
+	}
+	return nil
+}
+
+func (obj *objReader) read(b []byte) (n int, err error) {
+	if obj.sgl != nil {
+		return obj.sgl.Read(b) // already received (see deliverAsync)
+	}
 	if obj.pdu != nil {
 		return obj.readPDU(b)
 	}
 	debug.Assert(obj.Size() >= 0)
 	rem := obj.Size() - obj.off
+	if rem <= 0 {
+		// zero-length (header-only) object or the payload's been fully read:
+		// must not touch the stream - what follows is the next header
+		if obj.h.extra.RxProgress != nil {
+			obj.progress(true)
+		}
+		err = io.EOF
+		goto tr
+	}
 	if rem < int64(len(b)) {
 		b = b[:int(rem)]
 	}
 	n, err = obj.body.Read(b)
 	obj.off += int64(n) // NOTE: `GORACE` complaining here can be safely ignored
+	obj.setPending()
+	if obj.h.extra.RxProgress != nil {
+		obj.progress(obj.off >= obj.Size())
+	}
 	switch err {
 	case nil:
 		if obj.off >= obj.Size() {
@@ -349,14 +944,114 @@ func (obj *objReader) Read(b []byte) (n int, err error) {
 		}
 	case io.EOF:
 		if obj.off != obj.Size() {
-			err = fmt.Errorf("sbr6 %s: premature eof %d != %s, err %w", obj.loghdr, obj.off, obj, err)
+			err = fmt.Errorf("sbr6 %s: premature eof %d != %s: %w", obj.loghdr, obj.off, obj, ErrShortObject)
 		}
 	default:
 		err = fmt.Errorf("sbr7 %s: off %d, obj %s, err %w", obj.loghdr, obj.off, obj, err)
 	}
+tr:
+	if err == io.EOF && obj.hasTr && obj.trailer == nil {
+		if errTr := obj.readTrailer(); errTr != nil {
+			err = errTr
+		}
+	}
 	return
 }
 
+// decompress on the fly; upon failure (e.g., corrupted or truncated gzip payload), discard
+// the rest of the payload - to keep the stream going should the callback choose to continue
+func (obj *objReader) readGzip(b []byte) (n int, err error) {
+	if obj.gzr == nil {
+		obj.gzr = allocGzip()
+		err = obj.gzr.Reset(rawReader{obj})
+	}
+	if err == nil {
+		n, err = obj.gzr.Read(b)
+	}
+	if err != nil && err != io.EOF {
+		if _, errDrain := io.Copy(io.Discard, rawReader{obj}); errDrain != nil {
+			err = errDrain // (the stream is broken)
+		}
+		err = fmt.Errorf("sbr19 %s: failed to decompress %s, err %w", obj.loghdr, obj, err)
+	}
+	return
+}
+
+func (r rawReader) Read(b []byte) (int, error) { return r.obj.read(b) }
+
+// update session's `Pending` gauge: a single atomic store, no locking
+// (unsized objects are not accounted for)
+func (obj *objReader) setPending() {
+	if obj.stats == nil || obj.IsUnsized() {
+		return
+	}
+	obj.stats.Pending.Store(cos.MaxI64(obj.Size()-obj.off, 0))
+}
+
+// read the trailer that immediately follows the payload (compare with nextProtoHdr)
+func (obj *objReader) readTrailer() error {
+	var tbuf [maxSizeTrailer]byte
+	if _, err := io.ReadFull(obj.body, tbuf[:sizeProtoHdr]); err != nil {
+		return fmt.Errorf("sbr12 %s: failed to receive %s trailer, err %w", obj.loghdr, obj, err)
+	}
+	tlen, flags, err := extProtoHdr(tbuf[:], obj.loghdr)
+	if err != nil {
+		return err
+	}
+	if flags != trailerFl || tlen > maxSizeTrailer-sizeProtoHdr {
+		return fmt.Errorf("sbr12 %s: invalid %s trailer [tlen=%d, flags=%s]", obj.loghdr, obj, tlen, fl2s(flags))
+	}
+	if _, err := io.ReadFull(obj.body, tbuf[sizeProtoHdr:sizeProtoHdr+tlen]); err != nil {
+		return fmt.Errorf("sbr12 %s: failed to receive %s trailer, err %w", obj.loghdr, obj, err)
+	}
+	tr := extTrailer(tbuf[sizeProtoHdr:], tlen)
+	obj.trailer = &tr
+	return nil
+}
+
+// the callback is done short of the end of the payload and/or the trailer - e.g., upon reading
+// exactly the (decompressed) size of the object: discard the rest of the payload, if any,
+// and receive the trailer to keep the stream framing intact
+func (obj *objReader) drain() error {
+	if _, err := io.Copy(io.Discard, rawReader{obj}); err != nil {
+		return fmt.Errorf("sbr12 %s: failed to drain %s, err %w", obj.loghdr, obj, err)
+	}
+	return nil
+}
+
+// returns the trailer (if any) of the object that's being received; the trailer
+// becomes available once the object's payload has been fully read (see ObjTrailer),
+// and is valid only for the duration of the RecvObj callback; a callback that stops
+// short of that (see objReader.drain) does not get to see it
+func GetTrailer(object io.Reader) *ObjTrailer {
+	if obj, ok := object.(*objReader); ok {
+		return obj.trailer
+	}
+	return nil
+}
+
+// returns the remote peer that has sent the object (e.g., for per-source accounting);
+// the callback may reject objects from unexpected peers by returning an error, which
+// in turn terminates the stream
+func GetPeer(object io.Reader) (peer Peer) {
+	if obj, ok := object.(*objReader); ok && obj.peer != nil {
+		peer = *obj.peer
+	}
+	return
+}
+
+// rate-limited (see RxExtra.ProgressSize) progress callback
+func (obj *objReader) progress(done bool) {
+	if obj.off < obj.nextcb && !done {
+		return
+	}
+	if done && obj.nextcb == obj.off+obj.h.extra.ProgressSize {
+		return // already reported
+	}
+	obj.nextcb = obj.off + obj.h.extra.ProgressSize
+	obj.h.extra.RxProgress(obj.hdr, obj.off, obj.Size())
+}
+
 func (obj *objReader) String() string {
 	return fmt.Sprintf("%s(size=%d)", obj.hdr.FullName(), obj.Size())
 }
@@ -370,11 +1065,28 @@ func (obj *objReader) IsUnsized() bool { return obj.hdr.IsUnsized() }
 
 func (obj *objReader) readPDU(b []byte) (n int, err error) {
 	pdu := obj.pdu
+	if obj.err != nil {
+		return 0, obj.err
+	}
 	if pdu.woff == 0 {
 		err = pdu.readHdr(obj.loghdr)
+		if err == io.EOF {
+			// the stream ended short of the last PDU
+			err = fmt.Errorf("sbr8 %s: premature eof %d != %s: %w", obj.loghdr, obj.off, obj, ErrShortObject)
+		}
 		if err != nil {
 			return
 		}
+		// unsized objects (and PDU-based objects in general) are checked as they grow -
+		// prior to reading the next PDU's payload
+		if maxSize := obj.h.extra.MaxObjSize; maxSize > 0 && obj.off+int64(pdu.plen) > maxSize {
+			if obj.stats != nil {
+				obj.stats.Oversized.Inc()
+			}
+			obj.err = fmt.Errorf("sbr18 %s: %s exceeds the maximum object size %d (off %d, PDU %d)",
+				obj.loghdr, obj, maxSize, obj.off, pdu.plen)
+			return 0, obj.err
+		}
 	}
 	for !pdu.done {
 		if _, err = pdu.readFrom(); err != nil && err != io.EOF {
@@ -386,8 +1098,19 @@ func (obj *objReader) readPDU(b []byte) (n int, err error) {
 			runtime.Gosched()
 		}
 	}
+	if err == io.EOF {
+		// the stream ended - legitimately, only if at the very end of the last PDU
+		if pdu.plength() < pdu.plen || pdu.flags&pduLastFl == 0 {
+			return 0, fmt.Errorf("sbr8 %s: premature eof %d != %s: %w", obj.loghdr, obj.off, obj, ErrShortObject)
+		}
+		err = nil
+	}
 	n = pdu.read(b)
 	obj.off += int64(n)
+	obj.setPending()
+	if n > 0 && obj.h.extra.RxProgress != nil {
+		obj.progress(false)
+	}
 
 	if err != nil {
 		return
@@ -397,8 +1120,18 @@ func (obj *objReader) readPDU(b []byte) (n int, err error) {
 			err = io.EOF
 			if obj.IsUnsized() {
 				obj.hdr.ObjAttrs.Size = obj.off
-			} else if obj.Size() != obj.off {
-				glog.Errorf("sbr9 %s: off %d != %s", obj.loghdr, obj.off, obj)
+			} else if obj.off < obj.Size() {
+				return n, fmt.Errorf("sbr9 %s: off %d != %s: %w", obj.loghdr, obj.off, obj, ErrShortObject)
+			} else if obj.off > obj.Size() {
+				return n, fmt.Errorf("sbr9 %s: off %d != %s: %w", obj.loghdr, obj.off, obj, ErrLongObject)
+			}
+			if obj.h.extra.RxProgress != nil {
+				obj.progress(true)
+			}
+			if obj.hasTr && obj.trailer == nil {
+				if errTr := obj.readTrailer(); errTr != nil {
+					err = errTr
+				}
 			}
 		} else {
 			pdu.reset()
@@ -407,6 +1140,75 @@ func (obj *objReader) readPDU(b []byte) (n int, err error) {
 	return
 }
 
+//////////////
+// dlReader //
+//////////////
+
+func (d *dlReader) Read(b []byte) (n int, err error) {
+	if d.err != nil {
+		return 0, d.err
+	}
+	if err = d.conn.SetReadDeadline(time.Now().Add(d.timeout)); err != nil {
+		return 0, err
+	}
+	n, err = d.r.Read(b)
+	if err != nil && errors.Is(err, os.ErrDeadlineExceeded) {
+		d.err = &ErrReadTimeout{d.timeout}
+		return n, d.err
+	}
+	// (header reads, in particular, are not subject to the timeout)
+	if errD := d.conn.SetReadDeadline(time.Time{}); errD != nil && err == nil {
+		err = errD
+	}
+	return
+}
+
+// the receiving connection's read deadline, if settable
+func rxDeadliner(w http.ResponseWriter) readDeadliner {
+	for {
+		switch rw := w.(type) {
+		case readDeadliner:
+			return rw
+		case interface{ Unwrap() http.ResponseWriter }:
+			w = rw.Unwrap()
+		default:
+			return nil
+		}
+	}
+}
+
+/////////////////
+// chunkReader //
+/////////////////
+
+func (r *chunkReader) Read(p []byte) (int, error) { return io.ReadFull(r.br, p) }
+
+////////////////////
+// ErrReadTimeout //
+////////////////////
+
+func (e *ErrReadTimeout) Error() string {
+	return fmt.Sprintf("read timeout (%v)", e.timeout)
+}
+
+func IsErrReadTimeout(e error) bool {
+	var err *ErrReadTimeout
+	return errors.As(e, &err)
+}
+
+///////////////
+// ErrRxBusy //
+///////////////
+
+func (e *ErrRxBusy) Error() string {
+	return fmt.Sprintf("%s: busy (too many active streams or paused), try again later", e.dstURL)
+}
+
+func IsErrRxBusy(e error) bool {
+	var err *ErrRxBusy
+	return errors.As(e, &err)
+}
+
 ////////////////////////
 // ErrDuplicateTrname //
 ////////////////////////
@@ -420,6 +1222,19 @@ func IsErrDuplicateTrname(e error) bool {
 	return ok
 }
 
+///////////////////////
+// ErrInvalidHandler //
+///////////////////////
+
+func (e *ErrInvalidHandler) Error() string {
+	return fmt.Sprintf("invalid transport endpoint %q: %s", e.trname, e.reason)
+}
+
+func IsErrInvalidHandler(e error) bool {
+	var err *ErrInvalidHandler
+	return errors.As(e, &err)
+}
+
 //
 // session ID <=> unique ID
 //
@@ -446,7 +1261,7 @@ func DrainAndFreeReader(r io.Reader) {
 	obj, ok := r.(*objReader)
 	debug.Assert(ok)
 	if obj.body != nil && !obj.hdr.IsHeaderOnly() {
-		cos.DrainReader(obj)
+		cos.DrainReader(rawReader{obj})
 	}
 	FreeRecv(obj)
 }
@@ -0,0 +1,131 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// idle sessions get reaped by the housekeeper (see handler.cleanup)
+func TestSessionReaped(t *testing.T) {
+	const (
+		oldSessID    = 11
+		recentSessID = 22
+		numObjs      = 5
+	)
+	var (
+		now       = mono.NanoTime()
+		oldUID    = uint64(1)<<32 | oldSessID
+		recentUID = uint64(1)<<32 | recentSessID
+		reaped    = make(map[int64]int64)
+		h         = &handler{trname: "reap"}
+	)
+	h.extra.OnSessionClose = func(trname string, sessID int64, final *Stats) {
+		tassert.Errorf(t, trname == h.trname, "expected %q, got %q", h.trname, trname)
+		reaped[sessID] = final.Num.Load()
+	}
+	for _, uid := range []uint64{oldUID, recentUID} {
+		stats := newRxStats(cmn.NetIntraData)
+		stats.Num.Store(numObjs)
+		h.sessions.Store(uid, stats)
+	}
+	h.oldSessions.Store(oldUID, now-int64(sessionIsOld)-int64(time.Second))
+	h.oldSessions.Store(recentUID, now)
+
+	h.cleanup()
+	tassert.Fatalf(t, len(reaped) == 1, "expected exactly one reaped session, got %v", reaped)
+	tassert.Errorf(t, reaped[oldSessID] == numObjs, "expected final num %d, got %d", numObjs, reaped[oldSessID])
+	_, ok := h.sessions.Load(oldUID)
+	tassert.Errorf(t, !ok, "session %d must be gone", oldSessID)
+	_, ok = h.sessions.Load(recentUID)
+	tassert.Errorf(t, ok, "session %d must still be there", recentSessID)
+
+	// reaped once and only once
+	h.cleanup()
+	tassert.Errorf(t, len(reaped) == 1, "expected no more reaped sessions, got %v", reaped)
+
+	// nil-safe
+	h.extra.OnSessionClose = nil
+	h.oldSessions.Store(recentUID, now-int64(sessionIsOld)-int64(time.Second))
+	h.cleanup()
+	_, ok = h.sessions.Load(recentUID)
+	tassert.Errorf(t, !ok, "session %d must be gone", recentSessID)
+}
+
+// payload that does not match the header-declared size must not read as a clean EOF
+func TestObjReaderSize(t *testing.T) {
+	const size = 10 * cos.KiB
+	payload := make([]byte, size+cos.KiB)
+	for i := range payload {
+		payload[i] = byte(i)
+	}
+	// frame the payload as PDUs of (up to) 4KiB each
+	framed := func(b []byte, last bool) []byte {
+		var out []byte
+		for off := 0; off < len(b); off += 4 * cos.KiB {
+			chunk := b[off:cos.Min(off+4*cos.KiB, len(b))]
+			pdu := newSendPDU(make([]byte, sizeProtoHdr+4*cos.KiB))
+			pdu.woff += copy(pdu.buf[pdu.woff:], chunk)
+			pdu.last = last && off+len(chunk) == len(b)
+			pdu.insHeader()
+			out = append(out, pdu.buf[:pdu.woff]...)
+		}
+		return out
+	}
+	exactPDUs := framed(payload[:size], true)
+	for _, test := range []struct {
+		name string
+		body []byte
+		size int64
+		pdu  bool
+		exp  error
+	}{
+		{"exact", payload[:size], size, false, nil},
+		{"short", payload[:size-1], size, false, ErrShortObject},
+		{"empty", nil, size, false, ErrShortObject},
+		// (what follows the declared size is the next header - not read)
+		{"long", payload, size, false, nil},
+
+		{"pdu-exact", exactPDUs, size, true, nil},
+		{"pdu-unsized", exactPDUs, SizeUnknown, true, nil},
+		{"pdu-short", framed(payload[:size-1], true), size, true, ErrShortObject},
+		{"pdu-truncated", exactPDUs[:len(exactPDUs)-1], size, true, ErrShortObject},
+		{"pdu-not-last", framed(payload[:size], false), size, true, ErrShortObject},
+		{"pdu-long", framed(payload, true), size, true, ErrLongObject},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			body := bytes.NewReader(test.body)
+			obj := &objReader{body: body, h: &handler{trname: "size"}, loghdr: test.name}
+			obj.hdr.ObjName = test.name
+			obj.hdr.ObjAttrs.Size = test.size
+			if test.pdu {
+				obj.pdu = newRecvPDU(body, make([]byte, maxSizePDU))
+			}
+			b, err := io.ReadAll(obj)
+			if test.exp != nil {
+				tassert.Fatalf(t, errors.Is(err, test.exp), "expected %v, got %v", test.exp, err)
+				tassert.Errorf(t, !errors.Is(err, io.EOF), "must not be io.EOF: %v", err)
+				return
+			}
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, len(b) == size, "expected %d bytes, got %d", size, len(b))
+			tassert.Errorf(t, bytes.Equal(b, payload[:size]), "payload mismatch")
+			if !test.pdu {
+				tassert.Errorf(t, body.Len() == len(test.body)-size, "expected %d unread bytes, got %d",
+					len(test.body)-size, body.Len())
+			}
+		})
+	}
+}
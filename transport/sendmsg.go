@@ -102,14 +102,14 @@ func (s *MsgStream) send(b []byte) (n int, err error) {
 	if s.msgoff.off >= len(s.header) {
 		debug.Assert(s.msgoff.off == len(s.header))
 		s.stats.Offset.Add(int64(s.msgoff.off))
-		if verbose {
+		if verbose.Load() {
 			num := s.stats.Num.Load()
 			glog.Infof("%s: hlen=%d (%d/%d)", s, s.msgoff.off, s.Numcur, num)
 		}
 		s.msgoff.ins = inEOB
 		s.msgoff.off = 0
 		if s.msgoff.msg.isFin() {
-			if verbose {
+			if verbose.Load() {
 				glog.Infof("%s: sent last", s)
 			}
 			err = io.EOF
@@ -128,7 +128,7 @@ func (s *MsgStream) dryrun() {
 		it   = iterator{handler: h, body: body, hbuf: make([]byte, dfltMaxHdr)}
 	)
 	for {
-		hlen, flags, err := it.nextProtoHdr(s.String())
+		hlen, flags, err := it.nextProtoHdr(s.String(), false)
 		if err == io.EOF {
 			break
 		}
@@ -163,7 +163,7 @@ func (s *MsgStream) closeAndFree() {
 func (s *MsgStream) idleTick() {
 	if len(s.workCh) == 0 && s.sessST.CAS(active, inactive) {
 		s.workCh <- &Msg{Opcode: opcIdleTick}
-		if verbose {
+		if verbose.Load() {
 			glog.Infof("%s: active => inactive", s)
 		}
 	}
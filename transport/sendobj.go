@@ -21,9 +21,12 @@ import (
 // object stream & private types
 type (
 	Stream struct {
-		workCh   chan *Obj // aka SQ: next object to stream
-		cmplCh   chan cmpl // aka SCQ; note that SQ and SCQ together form a FIFO
-		callback ObjSentCB // to free SGLs, close files, etc.
+		workCh   chan *Obj   // aka SQ: next object to stream
+		cmplCh   chan cmpl   // aka SCQ; note that SQ and SCQ together form a FIFO
+		callback ObjSentCB   // to free SGLs, close files, etc.
+		codec    OpaqueCodec // (see Extra.Codec)
+		seq      uint64      // last stamped sequence number (see Extra.Seq)
+		seqOn    bool
 		sendoff  sendoff
 		lz4s     lz4Stream
 		streamBase
@@ -36,9 +39,10 @@ type (
 		frameChecksum bool        // true: checksum lz4 frames
 	}
 	sendoff struct {
-		obj Obj
-		off int64
-		ins int // in-send enum
+		obj  Obj
+		off  int64
+		ins  int // in-send enum
+		toff int // trailer offset
 	}
 	cmpl struct {
 		err error
@@ -203,12 +207,15 @@ func (s *Stream) Read(b []byte) (n int, err error) {
 				break
 			}
 		}
+		if err == io.EOF {
+			err = nil // end of payload (the last PDU) - not the end of stream
+		}
 		if s.pdu.rlength() > 0 {
 			n = s.sendPDU(b)
 			if s.pdu.rlength() == 0 {
 				s.sendoff.off += int64(s.pdu.slength())
 				if s.pdu.last {
-					s.eoObj(nil)
+					s.eoPayload(nil)
 				}
 				s.pdu.reset()
 			}
@@ -216,6 +223,8 @@ func (s *Stream) Read(b []byte) (n int, err error) {
 		return
 	case inHdr:
 		return s.sendHdr(b)
+	case inTrailer:
+		return s.sendTrailer(b)
 	}
 repeat:
 	select {
@@ -233,13 +242,17 @@ repeat:
 			}
 			return s.deactivate()
 		}
-		l := insObjHeader(s.maxhdr, &obj.Hdr, s.usePDU())
+		if s.seqOn && !obj.Hdr.isFin() && !obj.Hdr.isCtrl() {
+			s.seq++
+			obj.Hdr.Seq = s.seq
+		}
+		l := insObjHeader(s.maxhdr, &obj.Hdr, s.usePDU(), obj.hasTrailer())
 		s.header = s.maxhdr[:l]
 		s.sendoff.ins = inHdr
 		return s.sendHdr(b)
 	case <-s.stopCh.Listen():
 		num := s.stats.Num.Load()
-		if verbose {
+		if verbose.Load() {
 			glog.Infof("%s: stopped (%d/%d)", s, s.Numcur, num)
 		}
 		err = io.EOF
@@ -255,7 +268,7 @@ func (s *Stream) sendHdr(b []byte) (n int, err error) {
 	}
 	debug.Assert(s.sendoff.off == int64(len(s.header)))
 	s.stats.Offset.Add(s.sendoff.off)
-	if verbose {
+	if verbose.Load() {
 		num := s.stats.Num.Load()
 		glog.Infof("%s: hlen=%d (%d/%d)", s, s.sendoff.off, s.Numcur, num)
 	}
@@ -267,7 +280,7 @@ func (s *Stream) sendHdr(b []byte) (n int, err error) {
 	}
 	s.sendoff.off = 0
 	if obj.Hdr.isFin() {
-		if verbose {
+		if verbose.Load() {
 			glog.Infof("%s: sent last", s)
 		}
 		err = io.EOF
@@ -290,9 +303,9 @@ func (s *Stream) sendData(b []byte) (n int, err error) {
 			}
 			err = nil
 		}
-		s.eoObj(err)
+		s.eoPayload(err)
 	} else if s.sendoff.off >= objSize {
-		s.eoObj(err)
+		s.eoPayload(err)
 	}
 	return
 }
@@ -302,6 +315,31 @@ func (s *Stream) sendPDU(b []byte) (n int) {
 	return
 }
 
+// end-of-payload: when requested, send trailer prior to completing the object
+func (s *Stream) eoPayload(err error) {
+	obj := &s.sendoff.obj
+	if err != nil || !obj.hasTrailer() {
+		s.eoObj(err)
+		return
+	}
+	debug.Assert(len(s.maxhdr) >= maxSizeTrailer)
+	tr := obj.Trailer(obj.Hdr, s.sendoff.off)
+	l := insTrailer(s.maxhdr, &tr)
+	s.header = s.maxhdr[:l]
+	s.sendoff.ins, s.sendoff.toff = inTrailer, 0
+}
+
+func (s *Stream) sendTrailer(b []byte) (n int, err error) {
+	n = copy(b, s.header[s.sendoff.toff:])
+	s.sendoff.toff += n
+	if s.sendoff.toff < len(s.header) {
+		return
+	}
+	s.stats.Offset.Add(int64(s.sendoff.toff))
+	s.eoObj(nil)
+	return
+}
+
 // end-of-object:
 // - update stats, reset idle timeout, and post completion
 // - note that reader.Close() is done by `doCmpl`
@@ -320,11 +358,14 @@ func (s *Stream) eoObj(err error) {
 		err = fmt.Errorf("%s: %s offset %d != size", s, obj, s.sendoff.off)
 		goto exit
 	}
+	if obj.Hdr.isCtrl() {
+		goto exit // not an object
+	}
 	// this stream stats
 	s.stats.Size.Add(objSize)
 	s.Numcur++
 	s.stats.Num.Inc()
-	if verbose {
+	if verbose.Load() {
 		glog.Infof("%s: sent %s (%d/%d)", s, obj, s.Numcur, s.stats.Num.Load())
 	}
 	// target stats
@@ -349,13 +390,13 @@ func (s *Stream) dryrun() {
 		it   = iterator{handler: h, body: body, hbuf: make([]byte, dfltMaxHdr)}
 	)
 	for {
-		hlen, flags, err := it.nextProtoHdr(s.String())
+		hlen, flags, err := it.nextProtoHdr(s.String(), false)
 		if err == io.EOF {
 			break
 		}
 		debug.AssertNoErr(err)
 		debug.Assert(flags&msgFl == 0)
-		obj, err := it.nextObj(s.String(), hlen)
+		obj, err := it.nextObj(s.String(), hlen, flags)
 		if obj != nil {
 			cos.DrainReader(obj) // TODO: recycle `objReader` here
 			continue
@@ -399,7 +440,7 @@ func (s *Stream) closeAndFree() {
 func (s *Stream) idleTick() {
 	if len(s.workCh) == 0 && s.sessST.CAS(active, inactive) {
 		s.workCh <- &Obj{Hdr: ObjHdr{Opcode: opcIdleTick}}
-		if verbose {
+		if verbose.Load() {
 			glog.Infof("%s: active => inactive", s)
 		}
 	}
@@ -6,6 +6,8 @@
 package transport
 
 import (
+	"time"
+
 	"github.com/NVIDIA/aistore/3rdparty/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
@@ -24,7 +26,59 @@ type (
 		Size           atomic.Int64 // transferred object size (does not include transport headers)
 		Offset         atomic.Int64 // stream offset, in bytes
 		CompressedSize atomic.Int64 // compressed size (NOTE: converges to the actual compressed size over time)
+		Dropped        atomic.Int64 // Rx: number of objects and messages that failed to get received (and handled)
+		Rejected       atomic.Int64 // Rx: number of times the stream got terminated due to protocol (framing) errors
+		Pending        atomic.Int64 // Rx: gauge - bytes of the in-progress object not yet read by the receive callback
+		Oversized      atomic.Int64 // Rx: number of objects rejected for exceeding RxExtra.MaxObjSize
+		StartTime      atomic.Int64 // Rx: when the session was first seen (Unix nanoseconds)
+		LastActivity   atomic.Int64 // Rx: when the session last received an object or message (ditto)
+		// Rx: cumulative time (nanoseconds) spent receiving and parsing headers (including waiting
+		// for the next one to arrive) vs. delivering objects and messages - that is, reading payloads
+		// and running callbacks; both remain zero when disabled (see RxExtra.NoTiming)
+		HdrTime     atomic.Int64
+		PayloadTime atomic.Int64
+		network     string // Rx: the network the session arrives over (see GetStats)
 	}
 )
 
 var statsTracker cos.StatsTracker
+
+func newRxStats(network string) (s *Stats) {
+	s = &Stats{network: network}
+	now := time.Now().UnixNano()
+	s.StartTime.Store(now)
+	s.LastActivity.Store(now)
+	return
+}
+
+func (s *Stats) snap() (out *Stats) {
+	out = &Stats{network: s.network}
+	out.Num.Store(s.Num.Load())
+	out.Offset.Store(s.Offset.Load())
+	out.Size.Store(s.Size.Load())
+	out.Dropped.Store(s.Dropped.Load())
+	out.Rejected.Store(s.Rejected.Load())
+	out.Pending.Store(s.Pending.Load())
+	out.Oversized.Store(s.Oversized.Load())
+	out.StartTime.Store(s.StartTime.Load())
+	out.LastActivity.Store(s.LastActivity.Load()) // (not older than the Num loaded above)
+	out.HdrTime.Store(s.HdrTime.Load())
+	out.PayloadTime.Store(s.PayloadTime.Load())
+	return
+}
+
+// the fraction of (timed) receive time spent on headers - framing overhead that tends
+// to dominate streams of small objects; zero if not timed (see RxExtra.NoTiming)
+func (s *Stats) HdrTimeFraction() float64 {
+	hdr, payload := s.HdrTime.Load(), s.PayloadTime.Load()
+	if hdr+payload <= 0 {
+		return 0
+	}
+	return float64(hdr) / float64(hdr+payload)
+}
+
+// NOTE: activity gets recorded prior to incrementing the counter - see GetStats
+func (s *Stats) rxed() {
+	s.LastActivity.Store(time.Now().UnixNano())
+	s.Num.Inc()
+}
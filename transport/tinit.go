@@ -12,6 +12,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/3rdparty/atomic"
 	"github.com/NVIDIA/aistore/3rdparty/glog"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -22,20 +23,30 @@ const (
 	dfltBurstNum     = 32 // burst size (see: config.Transport.Burst)
 	dfltTick         = time.Second
 	dfltIdleTeardown = 4 * time.Second // (see config.Transport.IdleTeardown)
+	dfltProgressSize = cos.MiB * 8     // (see RxExtra.ProgressSize)
 )
 
 var (
-	dfltMaxHdr int64 // memsys.PageSize or cluster-configurable (`config.Transport.MaxHeaderSize`)
-	verbose    bool
+	dfltMaxHdr int64       // memsys.PageSize or cluster-configurable (`config.Transport.MaxHeaderSize`)
+	verbose    atomic.Bool // (see SetVerbose)
 )
 
 func init() {
 	nextSessionID.Store(100)
 	handlers = make(map[string]*handler, 32)
 	mu = &sync.RWMutex{}
-	verbose = bool(glog.FastV(4, glog.SmoduleTransport))
+	rxActive = make(map[string]*atomic.Int64, len(cmn.KnownNetworks))
+	for _, network := range cmn.KnownNetworks {
+		rxActive[network] = &atomic.Int64{}
+	}
+	verbose.Store(bool(glog.FastV(4, glog.SmoduleTransport)))
 }
 
+// enable/disable verbose (per-stream and per-object) tracing at runtime;
+// the initial value is determined by the transport log level (e.g., AIS_DEBUG=transport=4)
+func SetVerbose(v bool) { verbose.Store(v) }
+func IsVerbose() bool   { return verbose.Load() }
+
 func Init(st cos.StatsTracker, config *cmn.Config) *StreamCollector {
 	dfltMaxHdr = dfltSizeHeader
 	if config.Transport.MaxHeaderSize > 0 {
@@ -0,0 +1,105 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// StreamWriter produces a (binary-encoded) object stream that can be PUT directly
+// to the receiving endpoint (see ObjURLPath) - for producers that cannot use the
+// `Stream` (e.g., do not run intra-cluster HTTP client) but must remain conformant
+// with the receive side. The framing is the same as Stream's (see insObjHeader) minus
+// compression, PDUs, and trailers; in particular, objects must be sized.
+// The session ID is not a part of the framing - it is carried by the request
+// (see apc.HdrSessID).
+type StreamWriter struct {
+	w    io.Writer
+	dst  io.Writer // (see SetMaxRate)
+	hbuf []byte
+	num  int64 // objects written so far
+	fin  bool
+}
+
+// PackHeader serializes the object header, including the preceding 16-byte proto header
+// (length and flags followed by their checksum) - exactly as Stream would send it
+func PackHeader(hdr *ObjHdr) []byte {
+	hbuf := make([]byte, ObjHeaderSize(hdr))
+	l := insObjHeader(hbuf, hdr, false /*usePDU*/, false /*trailer*/)
+	return hbuf[:l]
+}
+
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	return &StreamWriter{w: w, dst: w, hbuf: make([]byte, dfltMaxHdr)}
+}
+
+// SetMaxRate paces all subsequent writes so as not to exceed the given rate (bytes per second);
+// zero removes the limit. Cooperating producers call it with the rate advertised by the receiver
+// upon the end of the previous session (see RxMaxRate).
+func (sw *StreamWriter) SetMaxRate(bytesPerSec int64) {
+	if bytesPerSec <= 0 {
+		sw.w = sw.dst
+		return
+	}
+	sw.w = &pacedWriter{w: sw.dst, pacer: pacer{rate: bytesPerSec}}
+}
+
+// RxMaxRate returns the max send rate advertised by the receiver in its response
+// (see RxRateCB), or zero when none
+func RxMaxRate(h http.Header) int64 { return parseMaxRate(h.Get(apc.HdrRxMaxRate)) }
+
+// WriteObj writes the header followed by exactly `hdr.ObjAttrs.Size` bytes read from
+// the reader (nil reader is permitted for header-only objects)
+func (sw *StreamWriter) WriteObj(hdr *ObjHdr, reader io.Reader) error {
+	switch {
+	case sw.fin:
+		return fmt.Errorf("%s: already terminated", sw)
+	case ReservedOpcode(hdr.Opcode):
+		return fmt.Errorf("%s: opcode %d is reserved", sw, hdr.Opcode)
+	case hdr.IsUnsized() || hdr.ObjSize() < 0:
+		return fmt.Errorf("%s: %s has unknown or invalid size %d", sw, hdr.FullName(), hdr.ObjSize())
+	case reader == nil && !hdr.IsHeaderOnly():
+		return fmt.Errorf("%s: %s (size %d) has no reader", sw, hdr.FullName(), hdr.ObjSize())
+	}
+	if err := sw.writeHdr(hdr); err != nil {
+		return err
+	}
+	if !hdr.IsHeaderOnly() {
+		if n, err := io.CopyN(sw.w, reader, hdr.ObjSize()); err != nil {
+			return fmt.Errorf("%s: failed to write %s (%d/%d), err %w", sw, hdr.FullName(), n, hdr.ObjSize(), err)
+		}
+	}
+	sw.num++
+	return nil
+}
+
+// Fin writes the last marker (see RxEndCB); no writes are permitted thereafter
+func (sw *StreamWriter) Fin() error {
+	if sw.fin {
+		return nil
+	}
+	sw.fin = true
+	return sw.writeHdr(&ObjHdr{Opcode: opcFin})
+}
+
+func (sw *StreamWriter) writeHdr(hdr *ObjHdr) (err error) {
+	hbuf := sw.hbuf
+	if size := ObjHeaderSize(hdr); size > len(hbuf) {
+		if size > maxSizeHeader {
+			return fmt.Errorf("%s: %s header size %d exceeds maximum %d", sw, hdr.FullName(), size, maxSizeHeader)
+		}
+		hbuf = make([]byte, size)
+	}
+	l := insObjHeader(hbuf, hdr, false /*usePDU*/, false /*trailer*/)
+	_, err = sw.w.Write(hbuf[:l])
+	return
+}
+
+func (sw *StreamWriter) String() string { return fmt.Sprintf("stream-writer[%d]", sw.num) }
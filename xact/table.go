@@ -126,6 +126,24 @@ var Table = map[string]Descriptor{
 		RefreshCap:  true,
 		Mountpath:   true,
 	},
+	apc.ActEnsureCopies: {
+		Scope:      ScopeB,
+		Access:     apc.AccessRW,
+		Startable:  true,
+		Metasync:   false,
+		Owned:      false,
+		RefreshCap: true,
+		Mountpath:  true,
+	},
+	apc.ActRebalanceCopies: {
+		Scope:      ScopeB,
+		Access:     apc.AccessRW,
+		Startable:  true,
+		Metasync:   false,
+		Owned:      false,
+		RefreshCap: true,
+		Mountpath:  true,
+	},
 	apc.ActMoveBck: {
 		DisplayName: "rename-bucket",
 		Scope:       ScopeB,
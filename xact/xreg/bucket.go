@@ -101,6 +101,14 @@ func RenewBckMakeNCopies(t cluster.Target, bck *cluster.Bck, uuid, tag string, c
 	return dreg.renew(e, bck)
 }
 
+func RenewBckEnsureCopies(t cluster.Target, uuid string, bck *cluster.Bck) RenewRes {
+	return RenewBucketXact(apc.ActEnsureCopies, bck, Args{T: t, UUID: uuid})
+}
+
+func RenewBckRebalanceCopies(t cluster.Target, uuid string, bck *cluster.Bck) RenewRes {
+	return RenewBucketXact(apc.ActRebalanceCopies, bck, Args{T: t, UUID: uuid})
+}
+
 func RenewPromote(t cluster.Target, uuid string, bck *cluster.Bck, args *cluster.PromoteArgs) RenewRes {
 	return RenewBucketXact(apc.ActPromote, bck, Args{T: t, Custom: args, UUID: uuid})
 }
@@ -90,6 +90,9 @@ func (r *bsummXact) Run(rwg *sync.WaitGroup) {
 	} else {
 		glog.Infof("%s - bucket(s) %s", r.Name(), r.Bck().Bucket())
 	}
+	if r.msg.Prefix != "" {
+		glog.Infof("%s - prefix %q", r.Name(), r.msg.Prefix)
+	}
 	if r.totalDisksSize, err = fs.GetTotalDisksSize(); err != nil {
 		r.updRes(err)
 		return
@@ -122,8 +125,9 @@ func (r *bsummXact) Run(rwg *sync.WaitGroup) {
 			if err := r.runBck(bck, listRemote); err != nil {
 				glog.Error(err)
 			}
-			return false // keep going
+			return r.IsAborted() // keep going unless aborted
 		})
+		err = r.AbortErr()
 	}
 	r.updRes(err)
 }
@@ -150,21 +154,41 @@ func (r *bsummXact) runBck(bck *cluster.Bck, listRemote bool) (err error) {
 }
 
 // TODO: `msg.Fast` might be a bit crude, usability-wise - consider adding (best effort) max-time limitation
+// NOTE: summaries are not cached - each request walks the bucket anew and is, therefore, never stale
+// (there's nothing to invalidate upon PUT/DELETE)
 func (r *bsummXact) _run(bck *cluster.Bck, summ *cmn.BsummResult, msg *cmn.BsummCtrlMsg) (err error) {
 	summ.Bck.Copy(bck.Bucket())
 
-	// 1. always estimate on-disk size (is fast)
-	summ.TotalSize.OnDisk = r.sizeOnDisk(bck)
-	if msg.Fast {
-		return
+	// 1. unless prefix-scoped, always estimate on-disk size (is fast)
+	if msg.Prefix == "" {
+		summ.TotalSize.OnDisk = r.sizeOnDisk(bck)
+		if msg.Fast && !msg.ReplHealth {
+			return
+		}
 	}
 
 	// 2. walk local pages
-	lsmsg := &apc.LsoMsg{Props: apc.GetPropsSize, Flags: apc.LsObjCached}
-	npg := newNpgCtx(r.t, bck, lsmsg, r.LomAdd)
+	if msg.SizeHist {
+		summ.SizeHist = cmn.NewBsummSizeHist(msg.HistBounds)
+	}
+	visited := r.LomAdd
+	if msg.ReplHealth {
+		repl := &cmn.BsummRepl{}
+		summ.Repl = repl
+		visited = func(lom *cluster.LOM) {
+			r.LomAdd(lom)
+			addRepl(repl, lom)
+		}
+	}
+	lsmsg := &apc.LsoMsg{Props: apc.GetPropsSize, Flags: apc.LsObjCached, Prefix: msg.Prefix}
+	npg := newNpgCtx(r.t, bck, lsmsg, visited)
+	npg.aborted = r.IsAborted
 	for {
 		npg.page.Entries = allocLsoEntries()
 		if err := npg.nextPageA(); err != nil {
+			if err == errStopped {
+				err = r.AbortErr()
+			}
 			return err
 		}
 		for _, v := range npg.page.Entries {
@@ -176,6 +200,9 @@ func (r *bsummXact) _run(bck *cluster.Bck, summ *cmn.BsummResult, msg *cmn.Bsumm
 				summ.ObjSize.Max = v.Size
 			}
 			summ.ObjCount.Present++
+			if summ.SizeHist != nil {
+				summ.SizeHist.Add(v.Size)
+			}
 		}
 		freeLsoEntries(npg.page.Entries)
 		if npg.page.ContinuationToken == "" {
@@ -183,6 +210,9 @@ func (r *bsummXact) _run(bck *cluster.Bck, summ *cmn.BsummResult, msg *cmn.Bsumm
 		}
 		lsmsg.ContinuationToken = npg.page.ContinuationToken
 	}
+	if msg.Prefix != "" {
+		summ.TotalSize.OnDisk = summ.TotalSize.PresentObjs
+	}
 
 	if msg.ObjCached {
 		return nil
@@ -190,8 +220,12 @@ func (r *bsummXact) _run(bck *cluster.Bck, summ *cmn.BsummResult, msg *cmn.Bsumm
 	debug.Assert(bck.IsRemote())
 
 	// 3. npg remote
-	lsmsg = &apc.LsoMsg{Props: apc.GetPropsSize}
+	summ.Remote = &cmn.BsummTally{}
+	lsmsg = &apc.LsoMsg{Props: apc.GetPropsSize, Prefix: msg.Prefix}
 	for {
+		if err := r.AbortErr(); err != nil {
+			return err
+		}
 		npg := newNpgCtx(r.t, bck, lsmsg, noopCb)
 		nentries := allocLsoEntries()
 		lst, err := npg.nextPageR(nentries)
@@ -199,17 +233,30 @@ func (r *bsummXact) _run(bck *cluster.Bck, summ *cmn.BsummResult, msg *cmn.Bsumm
 			return err
 		}
 		for _, v := range lst.Entries {
-			summ.TotalSize.RemoteObjs += uint64(v.Size)
-			summ.ObjCount.Remote++
+			summ.Remote.Size += uint64(v.Size)
+			summ.Remote.ObjCount++
 		}
 		freeLsoEntries(lst.Entries)
 		if lsmsg.ContinuationToken = lst.ContinuationToken; lsmsg.ContinuationToken == "" {
 			break
 		}
 	}
+	summ.ObjCount.Remote, summ.TotalSize.RemoteObjs = summ.Remote.ObjCount, summ.Remote.Size
 	return nil
 }
 
+// (the walk visits objects at their default locations - copies are not double-counted)
+func addRepl(repl *cmn.BsummRepl, lom *cluster.LOM) {
+	switch got, exp := lom.CountCopies(true /*verify*/), lom.ExpectedCopies(); {
+	case got < exp:
+		repl.Below++
+	case got > exp:
+		repl.Above++
+	default:
+		repl.Met++
+	}
+}
+
 func (*bsummXact) sizeOnDisk(bck *cluster.Bck) (size uint64) {
 	var (
 		avail = fs.GetAvail()
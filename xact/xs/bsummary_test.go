@@ -0,0 +1,136 @@
+// Package xs_test contains xs unit test.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// bucket with mirror.copies = 2, and deliberately under- and over-replicated objects
+func TestBsummReplHealth(t *testing.T) {
+	const (
+		numMpaths = 3
+		numEach   = 10
+	)
+	var (
+		tmpDir = t.TempDir()
+		buf    = make([]byte, cos.KiB)
+	)
+	config := cmn.GCO.BeginUpdate()
+	config.TestFSP.Count = 1
+	cmn.GCO.CommitUpdate(config)
+
+	hk.TestInit()
+	fs.TestNew(nil)
+	fs.TestDisableValidation()
+	for i := 0; i < numMpaths; i++ {
+		mpath := filepath.Join(tmpDir, fmt.Sprintf("mpath%d", i))
+		tassert.CheckFatal(t, cos.CreateDir(mpath))
+		_, err := fs.Add(mpath, "daeID")
+		tassert.CheckFatal(t, err)
+		defer fs.Remove(mpath)
+	}
+	_ = fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
+	_ = fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+
+	var (
+		props = &cmn.BucketProps{
+			Cksum:  cmn.CksumConf{Type: cos.ChecksumNone},
+			Mirror: cmn.MirrorConf{Enabled: true, Copies: 2},
+			BID:    1,
+		}
+		bck   = &cluster.Bck{Name: "bsumm-" + cos.GenTie(), Provider: apc.AIS, Ns: cmn.NsGlobal, Props: props}
+		tmock = mock.NewTarget(mock.NewBaseBownerMock(bck))
+		tsi   = cluster.NewSnode(tmock.SID(), apc.Target, cluster.NetInfo{}, cluster.NetInfo{}, cluster.NetInfo{})
+		tlso  = &lsoTarget{TargetMock: tmock, smap: &cluster.Smap{Tmap: cluster.NodeMap{tsi.ID(): tsi}, Version: 1}}
+	)
+	// met: 2 copies; below: 1 copy, and 2 copies with one missing on disk; above: 3 copies
+	put := func(prefix string, i, ncopies int, lose bool) {
+		lom := cluster.AllocLOM(fmt.Sprintf("%s/obj-%d", prefix, i))
+		defer cluster.FreeLOM(lom)
+		tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+		fh, err := cos.CreateFile(lom.FQN)
+		tassert.CheckFatal(t, err)
+		_, err = fh.Write(buf)
+		fh.Close()
+		tassert.CheckFatal(t, err)
+		lom.SetSize(int64(len(buf)))
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		tassert.CheckFatal(t, lom.Persist())
+
+		lom.Lock(true)
+		defer lom.Unlock(true)
+		for _, mi := range fs.GetAvail() {
+			if lom.NumCopies() >= ncopies {
+				break
+			}
+			if mi.Path == lom.MpathInfo().Path {
+				continue
+			}
+			copyFQN := mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+			tassert.CheckFatal(t, lom.Copy(mi, buf))
+			if lose {
+				tassert.CheckFatal(t, cos.RemoveFile(copyFQN))
+			}
+		}
+		tassert.Fatalf(t, lom.NumCopies() == ncopies, "%s: expected %d copies, got %d", lom, ncopies, lom.NumCopies())
+	}
+	for i := 0; i < numEach; i++ {
+		put("met", i, 2, false)
+		put("below", i, 1, false)
+		put("lost", i, 2, true)
+		put("above", i, 3, false)
+	}
+
+	xreg.TestReset()
+	xs.Xreg()
+	for _, test := range []struct {
+		name string
+		msg  cmn.BsummCtrlMsg
+		exp  *cmn.BsummRepl
+	}{
+		{"not-requested", cmn.BsummCtrlMsg{}, nil},
+		{"all", cmn.BsummCtrlMsg{ReplHealth: true, Fast: true}, &cmn.BsummRepl{Met: numEach, Below: 2 * numEach, Above: numEach}},
+		{"prefix", cmn.BsummCtrlMsg{ReplHealth: true, Prefix: "lost/"}, &cmn.BsummRepl{Below: numEach}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			msg := test.msg
+			msg.UUID = cos.GenUUID()
+			rns := xreg.RenewBckSummary(tlso, bck, &msg)
+			tassert.CheckFatal(t, rns.Err)
+			xctn := rns.Entry.Get()
+			for i := 0; i < 100 && !xctn.Finished(); i++ {
+				time.Sleep(50 * time.Millisecond)
+			}
+			tassert.Fatalf(t, xctn.Finished(), "%s: timed out", xctn)
+			res, err := xctn.(interface{ Result() (any, error) }).Result()
+			tassert.CheckFatal(t, err)
+
+			summaries := res.(cmn.AllBsummResults)
+			tassert.Fatalf(t, len(summaries) == 1, "expected a single bucket summary, got %d", len(summaries))
+			repl := summaries[0].Repl
+			if test.exp == nil {
+				tassert.Errorf(t, repl == nil, "expected no replication health, got %+v", repl)
+				return
+			}
+			tassert.Fatalf(t, repl != nil, "expected replication health")
+			tassert.Errorf(t, *repl == *test.exp, "expected %+v, got %+v", *test.exp, *repl)
+		})
+	}
+}
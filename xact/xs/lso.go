@@ -9,6 +9,7 @@ import (
 	"archive/tar"
 	"archive/zip"
 	"compress/gzip"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -46,14 +47,14 @@ type (
 	LsoXact struct {
 		streamingX
 		msg       *apc.LsoMsg
-		msgCh     chan *apc.LsoMsg // incoming requests
-		respCh    chan *LsoRsp     // responses - next pages
-		remtCh    chan *LsoRsp     // remote paging by the responsible target
-		stopCh    cos.StopCh       // to stop xaction
-		token     string           // continuation token -> last responded page
-		nextToken string           // next continuation token -> next pages
-		lastPage  cmn.LsoEntries   // last page contents
-		lensgl    int64            // sgl.Len()
+		msgCh     chan *lsoReq   // incoming requests
+		respCh    chan *LsoRsp   // responses - next pages
+		remtCh    chan *LsoRsp   // remote paging by the responsible target
+		stopCh    cos.StopCh     // to stop xaction
+		token     string         // continuation token -> last responded page
+		nextToken string         // next continuation token -> next pages
+		lastPage  cmn.LsoEntries // last page contents
+		lensgl    int64          // sgl.Len()
 		walk      struct {
 			pageCh chan *cmn.LsoEntry // channel to accumulate listed object entries
 			stopCh *cos.StopCh        // to abort bucket walk
@@ -62,6 +63,11 @@ type (
 			done   bool               // done walking
 		}
 	}
+	// page request along with the context of the (requesting) client
+	lsoReq struct {
+		ctx context.Context
+		msg *apc.LsoMsg
+	}
 	LsoRsp struct {
 		Err    error
 		Lst    *cmn.LsoResult
@@ -100,9 +106,9 @@ func (p *lsoFactory) Start() error {
 	r := &LsoXact{
 		streamingX: streamingX{p: &p.streamingF},
 		msg:        p.msg,
-		msgCh:      make(chan *apc.LsoMsg), // unbuffered
-		respCh:     make(chan *LsoRsp),     // ditto
-		remtCh:     make(chan *LsoRsp),     // ditto
+		msgCh:      make(chan *lsoReq), // unbuffered
+		respCh:     make(chan *LsoRsp), // ditto
+		remtCh:     make(chan *LsoRsp), // ditto
 	}
 	r.lastPage = allocLsoEntries()
 	r.stopCh.Init()
@@ -134,12 +140,13 @@ func (r *LsoXact) Run(*sync.WaitGroup) {
 	}
 	for {
 		select {
-		case msg := <-r.msgCh:
+		case req := <-r.msgCh:
 			// Copy only the values that can change between calls
+			msg := req.msg
 			debug.Assert(r.msg.UUID == msg.UUID && r.msg.Prefix == msg.Prefix && r.msg.Flags == msg.Flags)
 			r.msg.ContinuationToken = msg.ContinuationToken
 			r.msg.PageSize = msg.PageSize
-			r.respCh <- r.doPage()
+			r.respCh <- r.doPage(req.ctx)
 		case <-r.IdleTimer():
 			r.stop(nil)
 			return
@@ -218,19 +225,33 @@ func (r *LsoXact) initWalk() {
 	runtime.Gosched()
 }
 
-func (r *LsoXact) Do(msg *apc.LsoMsg) *LsoRsp {
+// Do is called with the context of the client's request: when the latter gets canceled
+// (or times out) the page is not completed, and the xaction aborts.
+func (r *LsoXact) Do(ctx context.Context, msg *apc.LsoMsg) (rsp *LsoRsp) {
+	if err := ctx.Err(); err != nil {
+		rsp = &LsoRsp{Status: http.StatusRequestTimeout, Err: err}
+		goto ex
+	}
 	// The guarantee here is that we either put something on the channel and our
 	// request will be processed (since the `msgCh` is unbuffered) or we receive
 	// message that the xaction has been stopped.
 	select {
-	case r.msgCh <- msg:
-		return <-r.respCh
+	case r.msgCh <- &lsoReq{ctx: ctx, msg: msg}:
+		rsp = <-r.respCh
 	case <-r.stopCh.Listen():
 		return &LsoRsp{Err: ErrGone}
+	case <-ctx.Done():
+		rsp = &LsoRsp{Status: http.StatusRequestTimeout, Err: ctx.Err()}
 	}
+ex:
+	// the client is gone (disconnected or timed out) - no need to keep walking
+	if err := ctx.Err(); err != nil {
+		r.Abort(err)
+	}
+	return rsp
 }
 
-func (r *LsoXact) doPage() *LsoRsp {
+func (r *LsoXact) doPage(ctx context.Context) *LsoRsp {
 	r.IncPending()
 	defer r.DecPending()
 
@@ -239,7 +260,7 @@ func (r *LsoXact) doPage() *LsoRsp {
 			// can't extract the next-to-list object name from the remotely generated
 			// continuation token, keeping and returning the entire last page
 			r.token = r.msg.ContinuationToken
-			if err := r.nextPageR(); err != nil {
+			if err := r.nextPageR(ctx); err != nil {
 				return &LsoRsp{Status: http.StatusInternalServerError, Err: err}
 			}
 		}
@@ -248,7 +269,9 @@ func (r *LsoXact) doPage() *LsoRsp {
 	}
 
 	if r.msg.ContinuationToken == "" || r.msg.ContinuationToken != r.token {
-		r.nextPageA()
+		if err := r.nextPageA(ctx); err != nil {
+			return &LsoRsp{Status: http.StatusRequestTimeout, Err: err}
+		}
 	}
 	var (
 		cnt  = r.msg.PageSize
@@ -286,7 +309,7 @@ func (r *LsoXact) havePage(token string, cnt uint) bool {
 	return idx+cnt < uint(len(r.lastPage))
 }
 
-func (r *LsoXact) nextPageR() error {
+func (r *LsoXact) nextPageR(ctx context.Context) error {
 	debug.Assert(r.msg.SID != "")
 	var (
 		page *cmn.LsoResult
@@ -326,6 +349,8 @@ func (r *LsoXact) nextPageR() error {
 			}
 		case <-r.stopCh.Listen():
 			err = ErrGone
+		case <-ctx.Done():
+			err = ctx.Err()
 		}
 	}
 	r.wiCnt.Dec()
@@ -391,7 +416,8 @@ func (r *LsoXact) gcLastPage(from, to int) {
 	}
 }
 
-func (r *LsoXact) nextPageA() {
+// returns error only when the client's context is done
+func (r *LsoXact) nextPageA(ctx context.Context) error {
 	if r.token > r.msg.ContinuationToken {
 		// restart traversing the bucket (TODO: cache more and try to scroll back)
 		r.walk.stopCh.Close()
@@ -401,17 +427,25 @@ func (r *LsoXact) nextPageA() {
 		r.lastPage = r.lastPage[:0]
 	} else {
 		if r.walk.done {
-			return
+			return nil
 		}
 		r.shiftLastPage(r.msg.ContinuationToken)
 	}
 	r.token = r.msg.ContinuationToken
 
 	if r.havePage(r.token, r.msg.PageSize) {
-		return
+		return nil
 	}
 	for cnt := uint(0); cnt < r.msg.PageSize; {
-		obj, ok := <-r.walk.pageCh
+		var (
+			obj *cmn.LsoEntry
+			ok  bool
+		)
+		select {
+		case obj, ok = <-r.walk.pageCh:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 		if !ok {
 			r.walk.done = true
 			break
@@ -423,6 +457,7 @@ func (r *LsoXact) nextPageA() {
 		cnt++
 		r.lastPage = append(r.lastPage, obj)
 	}
+	return nil
 }
 
 // Removes entries that were already sent to clients.
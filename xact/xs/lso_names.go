@@ -0,0 +1,58 @@
+// Package xs contains most of the supported eXtended actions (xactions) with some
+// exceptions that include certain storage services (mirror, EC) and extensions (downloader, lru).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// LsoNames lists explicitly named objects (see apc.LsoMsg.ObjNames) that are locally present,
+// short-circuiting the walk. Objects found at their (cluster-wide) HRW location are reported
+// with status apc.LocOK; objects found elsewhere (e.g., during rebalance) are reported with
+// the corresponding "misplaced" status - for the caller (proxy) to merge per-target results.
+// Absent names are not reported - it is the caller that figures out what's missing.
+func LsoNames(t cluster.Target, bck *cluster.Bck, msg *apc.LsoMsg) (lst *cmn.LsoResult, err error) {
+	var (
+		smap = t.Sowner().Get()
+		wi   = newWalkInfo(t, msg, noopCb)
+	)
+	lst = &cmn.LsoResult{UUID: msg.UUID, Entries: make(cmn.LsoEntries, 0, len(msg.ObjNames))}
+	for _, objName := range msg.ObjNames {
+		lom := cluster.AllocLOM(objName)
+		e, errN := wi.lsName(lom, bck, smap)
+		cluster.FreeLOM(lom)
+		if errN != nil {
+			return nil, errN
+		}
+		if e != nil {
+			lst.Entries = append(lst.Entries, e)
+		}
+	}
+	return
+}
+
+func (wi *walkInfo) lsName(lom *cluster.LOM, bck *cluster.Bck, smap *cluster.Smap) (*cmn.LsoEntry, error) {
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return nil, err
+	}
+	status := uint16(apc.LocOK)
+	_, local, err := lom.HrwTarget(smap)
+	if err != nil {
+		return nil, err
+	}
+	if !local {
+		status = apc.LocMisplacedNode
+	}
+	if err := lom.Load(local /*cache it*/, false /*locked*/); err != nil {
+		if cmn.IsErrObjNought(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return wi.ls(lom, status), nil
+}
@@ -0,0 +1,65 @@
+// Package xs contains most of the supported eXtended actions (xactions) with some
+// exceptions that include certain storage services (mirror, EC) and extensions (downloader, lru).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+var errPageDone = errors.New("page done")
+
+// LsoStream walks the bucket and calls back with each listed entry as soon as it is produced
+// (see apc.QparamLsoStream), in the ascending order of names. The walk stops upon reaching
+// `msg.PageSize` entries; the continuation token (and start-after) are honored the same
+// way as in paged listing but, unlike the latter, there's no xaction and no state
+// kept between calls. Only locally present objects are listed.
+// The walk is aborted as soon as `ctx` (the context of the client's request) is done.
+func LsoStream(ctx context.Context, t cluster.Target, bck *cluster.Bck, msg *apc.LsoMsg,
+	cb func(*cmn.LsoEntry) error) error {
+	var (
+		cnt uint
+		wi  = newWalkInfo(t, msg, noopCb)
+	)
+	opts := &fs.WalkBckOpts{
+		WalkOpts: fs.WalkOpts{CTs: []string{fs.ObjectType}, Sorted: true},
+	}
+	opts.WalkOpts.Bck.Copy(bck.Bucket())
+	opts.WalkOpts.Callback = func(fqn string, de fs.DirEntry) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		entry, err := wi.callback(fqn, de)
+		if err != nil || entry == nil {
+			return err
+		}
+		if entry.Name <= msg.StartAfter {
+			return nil
+		}
+		if err := cb(entry); err != nil {
+			return err
+		}
+		if cnt++; msg.PageSize > 0 && cnt >= msg.PageSize {
+			return errPageDone
+		}
+		return nil
+	}
+	opts.ValidateCallback = func(fqn string, de fs.DirEntry) error {
+		if de.IsDir() {
+			return wi.processDir(fqn)
+		}
+		return nil
+	}
+	if err := fs.WalkBck(opts); err != nil && err != errPageDone {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// Package xs_test contains xs unit test.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+const lsoNumObjs = 1000
+
+// mock target that also owns a (single-target) Smap
+type lsoTarget struct {
+	*mock.TargetMock
+	smap *cluster.Smap
+}
+
+func (t *lsoTarget) Sowner() cluster.Sowner { return t }
+func (t *lsoTarget) Get() *cluster.Smap     { return t.smap }
+
+func (*lsoTarget) Listeners() cluster.SmapListeners { return nil }
+
+func prepLso(t *testing.T) (*lsoTarget, *cluster.Bck) {
+	mpath := filepath.Join(t.TempDir(), "mpath")
+	tassert.CheckFatal(t, cos.CreateDir(mpath))
+
+	hk.TestInit()
+	fs.TestNew(nil)
+	fs.TestDisableValidation()
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	_ = fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
+
+	var (
+		props = &cmn.BucketProps{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}, BID: 1}
+		bck   = &cluster.Bck{Name: "lso-" + cos.GenTie(), Provider: apc.AIS, Ns: cmn.NsGlobal, Props: props}
+		tmock = mock.NewTarget(mock.NewBaseBownerMock(bck))
+		tsi   = cluster.NewSnode(tmock.SID(), apc.Target, cluster.NetInfo{}, cluster.NetInfo{}, cluster.NetInfo{})
+		smap  = &cluster.Smap{Tmap: cluster.NodeMap{tsi.ID(): tsi}, Version: 1}
+	)
+	for i := 0; i < lsoNumObjs; i++ {
+		lom := cluster.AllocLOM(fmt.Sprintf("obj-%04d", i))
+		tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+		fh, err := cos.CreateFile(lom.FQN)
+		tassert.CheckFatal(t, err)
+		_, err = fh.WriteString(lom.ObjName)
+		fh.Close()
+		tassert.CheckFatal(t, err)
+		lom.SetSize(int64(len(lom.ObjName)))
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		tassert.CheckFatal(t, lom.Persist())
+		cluster.FreeLOM(lom)
+	}
+	t.Cleanup(func() { fs.Remove(mpath) })
+	return &lsoTarget{TargetMock: tmock, smap: smap}, bck
+}
+
+// client goes away in the middle of a streamed listing
+func TestLsoStreamCanceled(t *testing.T) {
+	const stopAt = 10
+	var (
+		tlso, bck   = prepLso(t)
+		ctx, cancel = context.WithCancel(context.Background())
+		cnt         int
+	)
+	defer cancel()
+	err := xs.LsoStream(ctx, tlso, bck, &apc.LsoMsg{}, func(*cmn.LsoEntry) error {
+		if cnt++; cnt == stopAt {
+			cancel()
+		}
+		return nil
+	})
+	tassert.Fatalf(t, err == context.Canceled, "expected %v, got %v", context.Canceled, err)
+	tassert.Errorf(t, cnt == stopAt, "expected the walk to stop after %d entries, got %d", stopAt, cnt)
+}
+
+// client goes away in the middle of a paged listing
+func TestLsoXactCanceled(t *testing.T) {
+	const pageSize = 10
+	tlso, bck := prepLso(t)
+	xreg.TestReset()
+	xs.Xreg()
+
+	msg := &apc.LsoMsg{UUID: cos.GenUUID(), PageSize: pageSize, Flags: apc.LsObjCached}
+	rns := xreg.RenewLso(tlso, bck, msg.UUID, msg)
+	tassert.CheckFatal(t, rns.Err)
+	xls := rns.Entry.Get().(*xs.LsoXact)
+	go xls.Run(nil)
+
+	// first page
+	rsp := xls.Do(context.Background(), msg.Clone())
+	tassert.CheckFatal(t, rsp.Err)
+	tassert.Fatalf(t, len(rsp.Lst.Entries) == pageSize, "expected %d entries, got %d", pageSize, len(rsp.Lst.Entries))
+
+	// next page - canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	next := msg.Clone()
+	next.ContinuationToken = rsp.Lst.ContinuationToken
+	rsp = xls.Do(ctx, next)
+	tassert.Errorf(t, rsp.Err == context.Canceled, "expected %v, got %v", context.Canceled, rsp.Err)
+
+	// the xaction (and the walk) must stop promptly - well before the idle timeout
+	for i := 0; i < 100 && !xls.Finished(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	tassert.Fatalf(t, xls.Finished(), "%s: expected to finish upon client cancellation", xls)
+	tassert.Errorf(t, xls.IsAborted(), "%s: expected to be aborted", xls)
+}
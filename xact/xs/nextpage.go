@@ -16,10 +16,11 @@ import (
 )
 
 type npgCtx struct {
-	bck  *cluster.Bck
-	wi   walkInfo
-	page cmn.LsoResult
-	idx  int
+	bck     *cluster.Bck
+	aborted func() bool // (optional) checked on every visited object to stop walking
+	wi      walkInfo
+	page    cmn.LsoResult
+	idx     int
 }
 
 func newNpgCtx(t cluster.Target, bck *cluster.Bck, msg *apc.LsoMsg, cb lomVisitedCb) (npg *npgCtx) {
@@ -60,6 +61,9 @@ func (npg *npgCtx) nextPageA() error {
 }
 
 func (npg *npgCtx) cb(fqn string, de fs.DirEntry) error {
+	if npg.aborted != nil && npg.aborted() {
+		return errStopped
+	}
 	entry, err := npg.wi.callback(fqn, de)
 	if entry == nil && err == nil {
 		return nil
@@ -59,6 +59,19 @@ func setWanted(e *cmn.LsoEntry, lom *cluster.LOM, tmformat string, wanted cos.Bi
 			e.Location = lom.Location()
 		case apc.GetPropsCopies:
 			e.Copies = int16(lom.NumCopies())
+		case apc.GetPropsReplication:
+			e.Copies = int16(lom.NumCopies())
+			if over, _ := lom.IsOverReplicated(); over {
+				e.Flags |= apc.EntryOverReplicated
+			} else if mirror := lom.MirrorConf(); mirror.Enabled && int64(lom.NumCopies()) < lom.MirrorCopies() {
+				e.Flags |= apc.EntryUnderReplicated
+			}
+
+		case apc.GetPropsPlacement:
+			e.Location = lom.Location()
+			if st := e.Flags & apc.EntryStatusMask; st == apc.LocMisplacedNode || st == apc.LocMisplacedMountpath {
+				e.Flags |= apc.EntryMisplaced
+			}
 
 		case apc.GetPropsEC:
 			// TODO?: risk of significant slow-down loading EC metafiles
@@ -156,6 +156,10 @@ func (wi *walkInfo) cb(lom *cluster.LOM, fqn string) (*cmn.LsoEntry, error) {
 	if isOK(status) {
 		return wi.ls(lom, status), nil
 	}
+	// listing cached objects with placement: include misplaced objects (but not copies) as well
+	if status != apc.LocIsCopy && wi.msg.IsFlagSet(apc.LsObjCached) && wi.wanted.IsSet(allmap[apc.GetPropsPlacement]) {
+		return wi.ls(lom, status), nil
+	}
 
 	if !wi.msg.IsFlagSet(apc.LsAll) {
 		return nil, nil